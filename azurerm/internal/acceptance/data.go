@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
@@ -54,10 +55,17 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
+
+	// recorder records/replays the requests sent to Azure for this test, which is nil
+	// when requests are sent to Azure without being recorded
+	recorder *recording.Recorder
 }
 
 // BuildTestData generates some test data for the given resource
 func BuildTestData(t *testing.T, resourceType string, resourceLabel string) TestData {
+	// NOTE: this needs to happen first, since the credentials are set when replaying
+	recorder := recording.ForTest(t)
+
 	env, err := Environment()
 	if err != nil {
 		t.Fatalf("Error retrieving Environment: %+v", err)
@@ -73,6 +81,13 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 
 		ResourceType:  resourceType,
 		resourceLabel: resourceLabel,
+		recorder:      recorder,
+	}
+
+	// the random values need to be deterministic when replaying, so are generated from the recording
+	if recorder != nil {
+		testData.RandomInteger = randTimeInt(recorder.Now(), recorder.RandStringFromCharSet)
+		testData.RandomString = recorder.RandStringFromCharSet(5, charSetAlphaNum)
 	}
 
 	if features.UseDynamicTestLocations() {
@@ -85,6 +100,19 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		}
 	}
 
+	if recorder != nil {
+		locations := recorder.Locations(recording.Locations{
+			Primary:   testData.Locations.Primary,
+			Secondary: testData.Locations.Secondary,
+			Ternary:   testData.Locations.Ternary,
+		})
+		testData.Locations = Regions{
+			Primary:   locations.Primary,
+			Secondary: locations.Secondary,
+			Ternary:   locations.Ternary,
+		}
+	}
+
 	return testData
 }
 
@@ -123,6 +151,10 @@ func (td *TestData) RandomStringOfLength(len int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
+	if td.recorder != nil {
+		return td.recorder.RandStringFromCharSet(len, charSetAlphaNum)
+	}
+
 	return randString(len)
 }
 
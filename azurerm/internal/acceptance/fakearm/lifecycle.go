@@ -0,0 +1,125 @@
+package fakearm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// Lifecycle runs the Create, Read, Update and Delete functions for a Resource against the Server,
+// in the same way that Terraform would - tracking the State of the Resource between each step
+type Lifecycle struct {
+	t        *testing.T
+	ctx      context.Context
+	client   *clients.Client
+	resource *pluginsdk.Resource
+	state    *terraform.InstanceState
+}
+
+// Lifecycle returns a Lifecycle for the specified Resource Type (e.g. `azurerm_resource_group`)
+func (s *Server) Lifecycle(t *testing.T, resourceType string) *Lifecycle {
+	resource, ok := provider.TestAzureProvider().ResourcesMap[resourceType]
+	if !ok {
+		t.Fatalf("the Resource %q was not found in the Provider", resourceType)
+	}
+
+	ctx := context.TODO()
+	client, err := s.Client(ctx)
+	if err != nil {
+		t.Fatalf("building Client: %+v", err)
+	}
+
+	return &Lifecycle{
+		t:        t,
+		ctx:      ctx,
+		client:   client,
+		resource: resource,
+	}
+}
+
+// Client returns the Client used for this Resource, which is connected to the Server - and can be used
+// to configure any Provider-level settings prior to applying the Resource, or to call the Server directly
+func (l *Lifecycle) Client() *clients.Client {
+	return l.client
+}
+
+// State returns the current State for this Resource, which is nil once it's been destroyed
+func (l *Lifecycle) State() *terraform.InstanceState {
+	return l.state
+}
+
+// Apply creates (or updates) the Resource using the specified configuration, and then confirms that
+// applying the same configuration again results in no changes (that is, the Resource has no diff)
+func (l *Lifecycle) Apply(config map[string]interface{}) *terraform.InstanceState {
+	l.t.Helper()
+
+	diff, err := l.resource.Diff(l.ctx, l.state, terraform.NewResourceConfigRaw(config), l.client)
+	if err != nil {
+		l.t.Fatalf("planning changes: %+v", err)
+	}
+
+	if diff != nil && !diff.Empty() {
+		state, diags := l.resource.Apply(l.ctx, l.state, diff, l.client)
+		if diags.HasError() {
+			l.t.Fatalf("applying changes: %+v", diags)
+		}
+		l.state = state
+	}
+
+	l.Refresh()
+
+	diff, err = l.resource.Diff(l.ctx, l.state, terraform.NewResourceConfigRaw(config), l.client)
+	if err != nil {
+		l.t.Fatalf("planning changes after apply: %+v", err)
+	}
+	if diff != nil && !diff.Empty() {
+		l.t.Fatalf("expected no changes after apply but got: %+v", diff)
+	}
+
+	return l.state
+}
+
+// Refresh reads the Resource, updating the State - which is nil if the Resource no longer exists
+func (l *Lifecycle) Refresh() *terraform.InstanceState {
+	l.t.Helper()
+
+	if l.state == nil {
+		return nil
+	}
+
+	state, diags := l.resource.RefreshWithoutUpgrade(l.ctx, l.state, l.client)
+	if diags.HasError() {
+		l.t.Fatalf("refreshing: %+v", diags)
+	}
+	if state == nil || state.ID == "" {
+		state = nil
+	}
+	l.state = state
+
+	return l.state
+}
+
+// Destroy deletes the Resource
+func (l *Lifecycle) Destroy() {
+	l.t.Helper()
+
+	if l.state == nil {
+		l.t.Fatalf("unable to destroy the Resource since it doesn't exist")
+	}
+
+	diff := &terraform.InstanceDiff{
+		Destroy: true,
+	}
+	state, diags := l.resource.Apply(l.ctx, l.state, diff, l.client)
+	if diags.HasError() {
+		l.t.Fatalf("destroying: %+v", diags)
+	}
+	if state != nil && state.ID != "" {
+		l.t.Fatalf("expected the Resource to be removed from the State but got ID %q", state.ID)
+	}
+	l.state = nil
+}
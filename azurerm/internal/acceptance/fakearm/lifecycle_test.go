@@ -0,0 +1,34 @@
+package fakearm
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLifecycle_ResourceGroup(t *testing.T) {
+	server := NewServer(t)
+	lifecycle := server.Lifecycle(t, "azurerm_resource_group")
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-lifecycle", SubscriptionId)
+
+	state := lifecycle.Apply(map[string]interface{}{
+		"name":     "acctestRG-lifecycle",
+		"location": "West Europe",
+	})
+	if state.ID != id {
+		t.Fatalf("expected the ID to be %q but got %q", id, state.ID)
+	}
+
+	// the Client is connected to the Server, so the Resource Group can be retrieved using it
+	resp, err := lifecycle.Client().Resource.GroupsClient.Get(lifecycle.ctx, "acctestRG-lifecycle")
+	if err != nil {
+		t.Fatalf("retrieving Resource Group using the Client: %+v", err)
+	}
+	if resp.ID == nil || *resp.ID != id {
+		t.Fatalf("expected the Resource Group %q to be returned but got %+v", id, resp.ID)
+	}
+
+	lifecycle.Destroy()
+	if _, exists := server.Resource(id); exists {
+		t.Fatalf("expected the Resource Group %q to be deleted", id)
+	}
+}
@@ -0,0 +1,125 @@
+package fakearm
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	resourceGroupResourceType = "Microsoft.Resources/resourceGroups"
+	tagsResourceType          = "Microsoft.Resources/tags"
+)
+
+// resourceID is a parsed Azure Resource Manager Resource ID
+type resourceID struct {
+	// id is the Resource ID, as specified in the request
+	id string
+
+	// name is the name of this resource, being the last segment of the Resource ID
+	name string
+
+	// resourceType is the type of this Resource, for example `Microsoft.Network/virtualNetworks/subnets`
+	resourceType string
+
+	// resourceGroupId is the ID of the Resource Group containing this Resource, which is empty for
+	// Resources which aren't contained within a Resource Group
+	resourceGroupId string
+
+	// parentId is the ID of the Resource which this Resource is nested within (for example the Virtual
+	// Network containing a Subnet) - which is empty when this is a top-level Resource or Resource Group
+	parentId string
+}
+
+// key returns the key used to store this Resource, since Resource IDs are case-insensitive
+func (id resourceID) key() string {
+	return strings.ToLower(id.id)
+}
+
+func (id resourceID) isResourceGroup() bool {
+	return strings.EqualFold(id.resourceType, resourceGroupResourceType)
+}
+
+// isTags returns whether this is the Tags assigned to a Resource Group or Resource, which are managed using the Tags API
+func (id resourceID) isTags() bool {
+	return strings.EqualFold(id.resourceType, tagsResourceType) && id.tagsScope() != ""
+}
+
+// tagsScope returns the ID of the Resource Group or Resource which the Tags are assigned to
+func (id resourceID) tagsScope() string {
+	if id.parentId != "" {
+		return id.parentId
+	}
+
+	return id.resourceGroupId
+}
+
+// parseResourceID parses the path of the request into a Resource ID, for example:
+//
+//	/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}
+//	/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{namespace}/{type}/{name}
+//	/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{namespace}/{type}/{name}/{nestedType}/{nestedName}
+//
+// Extension Resources (where the path contains multiple `providers` segments) use the last of these
+func parseResourceID(path string) (*resourceID, error) {
+	path = strings.Trim(path, "/")
+	segments := strings.Split(path, "/")
+	if len(segments) < 4 || !strings.EqualFold(segments[0], "subscriptions") {
+		return nil, fmt.Errorf("expected a path in the format `/subscriptions/{subscriptionId}/...` but got %q", path)
+	}
+
+	// Azure returns the well-known segments using a consistent casing, regardless of the request
+	segments[0] = "subscriptions"
+	if strings.EqualFold(segments[2], "resourceGroups") {
+		segments[2] = "resourceGroups"
+	}
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") {
+			segments[i] = "providers"
+		}
+	}
+
+	id := resourceID{
+		id: fmt.Sprintf("/%s", strings.Join(segments, "/")),
+	}
+
+	if segments[2] == "resourceGroups" {
+		id.resourceGroupId = fmt.Sprintf("/%s", strings.Join(segments[0:4], "/"))
+		if len(segments) == 4 {
+			id.name = segments[3]
+			id.resourceType = resourceGroupResourceType
+			return &id, nil
+		}
+	}
+
+	providersIndex := -1
+	for i, segment := range segments {
+		if segment == "providers" {
+			providersIndex = i
+		}
+	}
+	if providersIndex == -1 || providersIndex+1 >= len(segments) {
+		return nil, fmt.Errorf("expected a Resource ID containing a `providers` segment but got %q", path)
+	}
+
+	// the remaining segments after the namespace should be pairs of {type}/{name}
+	typeSegments := segments[providersIndex+2:]
+	if len(typeSegments) == 0 || len(typeSegments)%2 != 0 {
+		return nil, fmt.Errorf("expected a Resource ID ending in `{type}/{name}` but got %q", path)
+	}
+
+	types := []string{segments[providersIndex+1]}
+	for i := 0; i < len(typeSegments); i += 2 {
+		types = append(types, typeSegments[i])
+	}
+	id.name = typeSegments[len(typeSegments)-1]
+	id.resourceType = strings.Join(types, "/")
+
+	if len(typeSegments) > 2 {
+		id.parentId = fmt.Sprintf("/%s", strings.Join(segments[0:len(segments)-2], "/"))
+	} else if providersIndex > 4 {
+		// the scope of an Extension Resource (e.g. a Management Lock) must exist
+		id.parentId = fmt.Sprintf("/%s", strings.Join(segments[0:providersIndex], "/"))
+	}
+
+	return &id, nil
+}
@@ -0,0 +1,87 @@
+package fakearm
+
+import "testing"
+
+func TestParseResourceID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *resourceID
+	}{
+		{
+			Input: "/subscriptions/12345",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/12345/resourcegroups/group1",
+			Expected: &resourceID{
+				id:              "/subscriptions/12345/resourceGroups/group1",
+				name:            "group1",
+				resourceType:    "Microsoft.Resources/resourceGroups",
+				resourceGroupId: "/subscriptions/12345/resourceGroups/group1",
+			},
+		},
+		{
+			Input: "/subscriptions/12345/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: &resourceID{
+				id:              "/subscriptions/12345/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				name:            "network1",
+				resourceType:    "Microsoft.Network/virtualNetworks",
+				resourceGroupId: "/subscriptions/12345/resourceGroups/group1",
+			},
+		},
+		{
+			Input: "/subscriptions/12345/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: &resourceID{
+				id:              "/subscriptions/12345/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+				name:            "subnet1",
+				resourceType:    "Microsoft.Network/virtualNetworks/subnets",
+				resourceGroupId: "/subscriptions/12345/resourceGroups/group1",
+				parentId:        "/subscriptions/12345/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			},
+		},
+		{
+			Input: "/subscriptions/12345/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &resourceID{
+				id:              "/subscriptions/12345/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks/lock1",
+				name:            "lock1",
+				resourceType:    "Microsoft.Authorization/locks",
+				resourceGroupId: "/subscriptions/12345/resourceGroups/group1",
+				parentId:        "/subscriptions/12345/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			},
+		},
+		{
+			Input: "/subscriptions/12345/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &resourceID{
+				id:           "/subscriptions/12345/providers/Microsoft.Authorization/locks/lock1",
+				name:         "lock1",
+				resourceType: "Microsoft.Authorization/locks",
+			},
+		},
+		{
+			// a list operation rather than a Resource
+			Input: "/subscriptions/12345/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseResourceID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
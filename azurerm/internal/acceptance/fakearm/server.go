@@ -0,0 +1,529 @@
+package fakearm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+const (
+	// SubscriptionId is the ID of the Subscription used by the clients connecting to the Server
+	SubscriptionId = "00000000-0000-0000-0000-000000000000"
+
+	// TenantId is the ID of the Tenant used by the clients connecting to the Server
+	TenantId = "00000000-0000-0000-0000-000000000001"
+
+	// operationsPath is the path used to poll long-running operations, which is intentionally
+	// outside of `/subscriptions` so that these can't conflict with a Resource ID
+	operationsPath = "/_operations/"
+)
+
+// Server is an in-process fake of Azure Resource Manager, which supports creating, retrieving,
+// updating and deleting Resource Groups and (generic) Resources, and updating the Tags assigned
+// to these using the Tags API - allowing the Create, Read, Update and Delete functions for
+// simple resources to be tested without connecting to Azure.
+//
+// Resources are stored as they're sent (with the `id`, `name`, `type` and `provisioningState`
+// fields populated) - as such any Computed fields which Azure would return aren't populated.
+type Server struct {
+	server *httptest.Server
+
+	lock       sync.Mutex
+	resources  map[string]map[string]interface{}
+	operations map[string]*operation
+	requests   []string
+
+	// longRunningTypes are the (lower-cased) Resource Types where PUT's and DELETE's are
+	// long-running operations, rather than completing immediately
+	longRunningTypes map[string]struct{}
+
+	// pollingAttempts is the number of times a long-running operation is reported as
+	// being in progress before completing
+	pollingAttempts int
+
+	operationCount int
+}
+
+type operation struct {
+	// method is the HTTP Method which started this operation - either PUT or DELETE
+	method string
+
+	// resourceId is the ID of the Resource being created/updated or deleted
+	resourceId resourceID
+
+	// remaining is the number of times this operation will be reported as in progress
+	remaining int
+}
+
+// NewServer starts a new Server, which is stopped once the test completes
+func NewServer(t *testing.T) *Server {
+	s := &Server{
+		resources:        map[string]map[string]interface{}{},
+		operations:       map[string]*operation{},
+		longRunningTypes: map[string]struct{}{},
+		pollingAttempts:  1,
+	}
+	s.server = httptest.NewServer(s)
+	t.Cleanup(s.server.Close)
+	return s
+}
+
+// URL returns the Resource Manager Endpoint for this Server
+func (s *Server) URL() string {
+	return fmt.Sprintf("%s/", s.server.URL)
+}
+
+// WithLongRunningOperations specifies that creating, updating and deleting the specified Resource Types
+// (e.g. `Microsoft.ManagedIdentity/userAssignedIdentities`) are long-running operations.
+//
+// Deleting a Resource Group is always a long-running operation, matching Azure.
+func (s *Server) WithLongRunningOperations(resourceTypes ...string) *Server {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, resourceType := range resourceTypes {
+		s.longRunningTypes[strings.ToLower(resourceType)] = struct{}{}
+	}
+	return s
+}
+
+// WithPollingAttempts specifies the number of times that long-running operations are reported
+// as being in progress before completing, which defaults to 1
+func (s *Server) WithPollingAttempts(attempts int) *Server {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.pollingAttempts = attempts
+	return s
+}
+
+// ClientOptions returns the ClientOptions required to connect to this Server, where the
+// Resource Manager Endpoint is overridden to this Server and no authorization is used
+func (s *Server) ClientOptions() *common.ClientOptions {
+	environment := azure.PublicCloud
+	environment.ResourceManagerEndpoint = s.URL()
+
+	return &common.ClientOptions{
+		SubscriptionId:              SubscriptionId,
+		TenantID:                    TenantId,
+		GraphAuthorizer:             autorest.NullAuthorizer{},
+		KeyVaultAuthorizer:          autorest.NullAuthorizer{},
+		ResourceManagerAuthorizer:   autorest.NullAuthorizer{},
+		ResourceManagerEndpoint:     s.URL(),
+		StorageAuthorizer:           autorest.NullAuthorizer{},
+		SynapseAuthorizer:           autorest.NullAuthorizer{},
+		SkipProviderReg:             true,
+		DisableCorrelationRequestID: true,
+		DisableTerraformPartnerID:   true,
+		Environment:                 environment,
+		Features:                    features.Default(),
+	}
+}
+
+// Client returns a Client which is configured to connect to this Server
+func (s *Server) Client(ctx context.Context) (*clients.Client, error) {
+	o := s.ClientOptions()
+
+	client := clients.Client{
+		Account: &clients.ResourceManagerAccount{
+			Environment:                      o.Environment,
+			SkipResourceProviderRegistration: true,
+			SubscriptionId:                   SubscriptionId,
+			TenantId:                         TenantId,
+		},
+	}
+	if err := client.Build(ctx, o); err != nil {
+		return nil, fmt.Errorf("building Client: %+v", err)
+	}
+
+	return &client, nil
+}
+
+// Resource returns the Resource with the specified ID, if it exists
+func (s *Server) Resource(id string) (map[string]interface{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	resource, ok := s.resources[strings.ToLower(id)]
+	return resource, ok
+}
+
+// Requests returns each request sent to this Server in the format `{METHOD} {path}`
+func (s *Server) Requests() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	out := make([]string, len(s.requests))
+	copy(out, s.requests)
+	return out
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.requests = append(s.requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+
+	if strings.HasPrefix(r.URL.Path, operationsPath) {
+		s.pollOperation(w, r, strings.TrimPrefix(r.URL.Path, operationsPath))
+		return
+	}
+
+	id, err := parseResourceID(r.URL.Path)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "InvalidResourceId", err.Error())
+		return
+	}
+
+	if id.isTags() {
+		s.patchTags(w, r, *id)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.getResource(w, *id)
+	case http.MethodHead:
+		s.resourceExists(w, *id)
+	case http.MethodPut:
+		s.putResource(w, r, *id)
+	case http.MethodPatch:
+		s.patchResource(w, r, *id)
+	case http.MethodDelete:
+		s.deleteResource(w, r, *id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q isn't supported", r.Method))
+	}
+}
+
+func (s *Server) getResource(w http.ResponseWriter, id resourceID) {
+	resource, ok := s.resources[id.key()]
+	if !ok {
+		s.writeNotFound(w, id)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resource)
+}
+
+func (s *Server) resourceExists(w http.ResponseWriter, id resourceID) {
+	if _, ok := s.resources[id.key()]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) putResource(w http.ResponseWriter, r *http.Request, id resourceID) {
+	if !s.parentExists(w, id) {
+		return
+	}
+
+	resource, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	_, exists := s.resources[id.key()]
+	resource["id"] = id.id
+	resource["name"] = id.name
+	resource["type"] = id.resourceType
+
+	status := http.StatusCreated
+	provisioningState := "Creating"
+	if exists {
+		status = http.StatusOK
+		provisioningState = "Updating"
+	}
+
+	if !s.isLongRunning(id) {
+		setProvisioningState(resource, "Succeeded")
+		s.resources[id.key()] = resource
+		writeJSON(w, status, resource)
+		return
+	}
+
+	setProvisioningState(resource, provisioningState)
+	s.resources[id.key()] = resource
+
+	operationId := s.startOperation(http.MethodPut, id)
+	w.Header().Set("Azure-AsyncOperation", s.operationURL(r, operationId))
+	w.Header().Set(autorest.HeaderRetryAfter, "0")
+	writeJSON(w, http.StatusCreated, resource)
+}
+
+func (s *Server) patchResource(w http.ResponseWriter, r *http.Request, id resourceID) {
+	existing, ok := s.resources[id.key()]
+	if !ok {
+		s.writeNotFound(w, id)
+		return
+	}
+
+	patch, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+	delete(patch, "id")
+	delete(patch, "name")
+	delete(patch, "type")
+
+	mergePatch(existing, patch)
+	writeJSON(w, http.StatusOK, existing)
+}
+
+// patchTags updates the Tags assigned to the Resource using the Tags API (the Extension Resource
+// `Microsoft.Resources/tags/default`), which supports merging, replacing and deleting Tags
+func (s *Server) patchTags(w http.ResponseWriter, r *http.Request, id resourceID) {
+	if r.Method != http.MethodPatch {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q isn't supported for Tags", r.Method))
+		return
+	}
+
+	resource, ok := s.resources[strings.ToLower(id.tagsScope())]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource '%s' was not found.", id.tagsScope()))
+		return
+	}
+
+	body, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+	patch := map[string]interface{}{}
+	if properties, ok := body["properties"].(map[string]interface{}); ok {
+		if v, ok := properties["tags"].(map[string]interface{}); ok {
+			patch = v
+		}
+	}
+
+	existing, ok := resource["tags"].(map[string]interface{})
+	if !ok {
+		existing = map[string]interface{}{}
+	}
+
+	switch body["operation"] {
+	case "Merge":
+		for k, v := range patch {
+			existing[k] = v
+		}
+	case "Replace":
+		existing = patch
+	case "Delete":
+		for k := range patch {
+			delete(existing, k)
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "InvalidTagsOperation", fmt.Sprintf("the operation %q isn't supported", body["operation"]))
+		return
+	}
+	resource["tags"] = existing
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":   id.id,
+		"name": id.name,
+		"type": id.resourceType,
+		"properties": map[string]interface{}{
+			"tags": existing,
+		},
+	})
+}
+
+func (s *Server) deleteResource(w http.ResponseWriter, r *http.Request, id resourceID) {
+	if _, ok := s.resources[id.key()]; !ok {
+		if id.isResourceGroup() {
+			s.writeNotFound(w, id)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if !id.isResourceGroup() && !s.isLongRunning(id) {
+		s.removeResource(id)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	setProvisioningState(s.resources[id.key()], "Deleting")
+
+	operationId := s.startOperation(http.MethodDelete, id)
+	w.Header().Set("Location", s.operationURL(r, operationId))
+	w.Header().Set(autorest.HeaderRetryAfter, "0")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) pollOperation(w http.ResponseWriter, r *http.Request, operationId string) {
+	op, ok := s.operations[operationId]
+	if !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("the operation %q was not found", operationId))
+		return
+	}
+
+	if op.remaining > 0 {
+		op.remaining--
+		w.Header().Set(autorest.HeaderRetryAfter, "0")
+		if op.method == http.MethodDelete {
+			w.Header().Set("Location", s.operationURL(r, operationId))
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"status": "InProgress",
+		})
+		return
+	}
+
+	if op.method == http.MethodDelete {
+		s.removeResource(op.resourceId)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if resource, ok := s.resources[op.resourceId.key()]; ok {
+		setProvisioningState(resource, "Succeeded")
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"status": "Succeeded",
+	})
+}
+
+// parentExists returns whether the Resource Group and/or parent Resource containing the specified
+// Resource exists, writing the error returned from Azure when it doesn't
+func (s *Server) parentExists(w http.ResponseWriter, id resourceID) bool {
+	if id.resourceGroupId != "" && !id.isResourceGroup() {
+		if _, ok := s.resources[strings.ToLower(id.resourceGroupId)]; !ok {
+			writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", resourceGroupName(id)))
+			return false
+		}
+	}
+
+	if id.parentId != "" {
+		if _, ok := s.resources[strings.ToLower(id.parentId)]; !ok {
+			writeError(w, http.StatusNotFound, "ParentResourceNotFound", fmt.Sprintf("Can not perform requested operation on nested resource. Parent resource '%s' not found.", id.parentId))
+			return false
+		}
+	}
+
+	return true
+}
+
+// removeResource removes the specified Resource and any Resources nested within it (including
+// all of the Resources within a Resource Group)
+func (s *Server) removeResource(id resourceID) {
+	prefix := fmt.Sprintf("%s/", id.key())
+	for key := range s.resources {
+		if key == id.key() || strings.HasPrefix(key, prefix) {
+			delete(s.resources, key)
+		}
+	}
+}
+
+func (s *Server) isLongRunning(id resourceID) bool {
+	_, ok := s.longRunningTypes[strings.ToLower(id.resourceType)]
+	return ok
+}
+
+func (s *Server) startOperation(method string, id resourceID) string {
+	s.operationCount++
+	operationId := fmt.Sprintf("operation%d", s.operationCount)
+	s.operations[operationId] = &operation{
+		method:     method,
+		resourceId: id,
+		remaining:  s.pollingAttempts,
+	}
+	return operationId
+}
+
+func (s *Server) operationURL(r *http.Request, operationId string) string {
+	return fmt.Sprintf("http://%s%s%s", r.Host, operationsPath, operationId)
+}
+
+func (s *Server) writeNotFound(w http.ResponseWriter, id resourceID) {
+	if id.resourceGroupId != "" {
+		if _, ok := s.resources[strings.ToLower(id.resourceGroupId)]; !ok {
+			writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", resourceGroupName(id)))
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource '%s/%s' under resource group '%s' was not found.", id.resourceType, id.name, resourceGroupName(id)))
+}
+
+func resourceGroupName(id resourceID) string {
+	segments := strings.Split(id.resourceGroupId, "/")
+	return segments[len(segments)-1]
+}
+
+func readBody(r *http.Request) (map[string]interface{}, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading request body: %+v", err)
+	}
+
+	out := map[string]interface{}{}
+	if len(body) == 0 {
+		return out, nil
+	}
+
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, fmt.Errorf("parsing request body: %+v", err)
+	}
+	return out, nil
+}
+
+func setProvisioningState(resource map[string]interface{}, state string) {
+	properties, ok := resource["properties"].(map[string]interface{})
+	if !ok {
+		properties = map[string]interface{}{}
+		resource["properties"] = properties
+	}
+	properties["provisioningState"] = state
+}
+
+// mergePatch applies the patch to the existing resource - where nested objects are merged
+// and any other values (including lists) are replaced
+func mergePatch(existing, patch map[string]interface{}) {
+	for key, value := range patch {
+		nestedPatch, isObject := value.(map[string]interface{})
+		nestedExisting, existingIsObject := existing[key].(map[string]interface{})
+		if isObject && existingIsObject && key != "tags" {
+			mergePatch(nestedExisting, nestedPatch)
+			continue
+		}
+
+		existing[key] = value
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body) // nolint:errcheck
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
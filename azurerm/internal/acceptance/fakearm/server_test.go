@@ -0,0 +1,159 @@
+package fakearm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestServer_LongRunningOperations(t *testing.T) {
+	server := NewServer(t).
+		WithLongRunningOperations("Microsoft.Network/virtualNetworks").
+		WithPollingAttempts(2)
+
+	ctx := context.TODO()
+	client, err := server.Client(ctx)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	groupsClient := client.Resource.GroupsClient
+	resourcesClient := client.Resource.ResourcesClient
+
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1", SubscriptionId)
+	network := resources.GenericResource{
+		Location: utils.String("westeurope"),
+	}
+
+	// the Resource Group must exist first
+	if _, err := resourcesClient.CreateOrUpdateByID(ctx, id, "2020-11-01", network); err == nil {
+		t.Fatalf("expected an error when the Resource Group doesn't exist but didn't get one")
+	}
+
+	if _, err := groupsClient.CreateOrUpdate(ctx, "group1", resources.Group{Location: utils.String("westeurope")}); err != nil {
+		t.Fatalf("creating Resource Group: %+v", err)
+	}
+
+	future, err := resourcesClient.CreateOrUpdateByID(ctx, id, "2020-11-01", network)
+	if err != nil {
+		t.Fatalf("creating Virtual Network: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, resourcesClient.Client); err != nil {
+		t.Fatalf("waiting for creation of Virtual Network: %+v", err)
+	}
+
+	resp, err := resourcesClient.GetByID(ctx, id, "2020-11-01")
+	if err != nil {
+		t.Fatalf("retrieving Virtual Network: %+v", err)
+	}
+	if resp.Properties == nil || resp.Properties.(map[string]interface{})["provisioningState"] != "Succeeded" {
+		t.Fatalf("expected the Virtual Network to be provisioned but got %+v", resp.Properties)
+	}
+
+	deleteFuture, err := groupsClient.Delete(ctx, "group1", "")
+	if err != nil {
+		t.Fatalf("deleting Resource Group: %+v", err)
+	}
+	if err := deleteFuture.WaitForCompletionRef(ctx, groupsClient.Client); err != nil {
+		t.Fatalf("waiting for deletion of Resource Group: %+v", err)
+	}
+
+	if _, exists := server.Resource(id); exists {
+		t.Fatalf("expected the Virtual Network to be deleted along with the Resource Group")
+	}
+
+	polls := 0
+	for _, request := range server.Requests() {
+		if strings.HasPrefix(request, http.MethodGet+" "+operationsPath) {
+			polls++
+		}
+	}
+	// 3 polls (2 in progress and 1 completed) for both the Virtual Network and the Resource Group
+	if polls != 6 {
+		t.Fatalf("expected 6 requests polling the long-running operations but got %d", polls)
+	}
+}
+
+func TestServer_Tags(t *testing.T) {
+	server := NewServer(t)
+
+	ctx := context.TODO()
+	client, err := server.Client(ctx)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	groupsClient := client.Resource.GroupsClient
+	tagsClient := client.Resource.TagsClient
+
+	group := resources.Group{
+		Location: utils.String("westeurope"),
+		Tags: map[string]*string{
+			"environment": utils.String("Production"),
+			"owner":       utils.String("platform-team"),
+		},
+	}
+	if _, err := groupsClient.CreateOrUpdate(ctx, "group1", group); err != nil {
+		t.Fatalf("creating Resource Group: %+v", err)
+	}
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/group1", SubscriptionId)
+
+	testData := []struct {
+		operation resources.TagsPatchOperation
+		tags      map[string]*string
+		expected  map[string]interface{}
+	}{
+		{
+			operation: resources.TagsPatchOperationMerge,
+			tags: map[string]*string{
+				"environment": utils.String("Development"),
+				"cost-center": utils.String("1234"),
+			},
+			expected: map[string]interface{}{
+				"environment": "Development",
+				"owner":       "platform-team",
+				"cost-center": "1234",
+			},
+		},
+		{
+			operation: resources.TagsPatchOperationDelete,
+			tags: map[string]*string{
+				"owner": utils.String("platform-team"),
+			},
+			expected: map[string]interface{}{
+				"environment": "Development",
+				"cost-center": "1234",
+			},
+		},
+		{
+			operation: resources.TagsPatchOperationReplace,
+			tags: map[string]*string{
+				"hello": utils.String("world"),
+			},
+			expected: map[string]interface{}{
+				"hello": "world",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		patch := resources.TagsPatchResource{
+			Operation: v.operation,
+			Properties: &resources.Tags{
+				Tags: v.tags,
+			},
+		}
+		if _, err := tagsClient.UpdateAtScope(ctx, id, patch); err != nil {
+			t.Fatalf("updating Tags (%s): %+v", v.operation, err)
+		}
+
+		resource, _ := server.Resource(id)
+		if actual := resource["tags"]; !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected the Tags to be %+v after %s but got %+v", v.expected, v.operation, actual)
+		}
+	}
+}
@@ -6,17 +6,29 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/recording"
 )
 
+// NOTE: when recording or replaying requests these values are generated from the recording for the
+// test which is being set up, so must be generated before the test steps are run to be deterministic
+
 func RandTimeInt() int {
+	if recorder := recording.Current(); recorder != nil {
+		return randTimeInt(recorder.Now(), recorder.RandStringFromCharSet)
+	}
+
+	return randTimeInt(time.Now().Local(), acctest.RandStringFromCharSet)
+}
+
+func randTimeInt(now time.Time, randStringFromCharSet func(strlen int, charSet string) string) int {
 	// acctest.RantInt() returns a value of size:
 	// 000000000000000000
 	// YYMMddHHmmsshhRRRR
 
 	// go format: 2006-01-02 15:04:05.00
 
-	timeStr := strings.Replace(time.Now().Local().Format("060102150405.00"), ".", "", 1) // no way to not have a .?
-	postfix := acctest.RandStringFromCharSet(4, "0123456789")
+	timeStr := strings.Replace(now.Format("060102150405.00"), ".", "", 1) // no way to not have a .?
+	postfix := randStringFromCharSet(4, "0123456789")
 
 	i, err := strconv.Atoi(timeStr + postfix)
 	if err != nil {
@@ -28,9 +40,17 @@ func RandTimeInt() int {
 
 // RandString generates a random alphanumeric string of the length specified
 func RandString(strlen int) string {
+	if recorder := recording.Current(); recorder != nil {
+		return recorder.RandStringFromCharSet(strlen, acctest.CharSetAlphaNum)
+	}
+
 	return acctest.RandString(strlen)
 }
 
 func RandStringFromCharSet(strlen int, charSet string) string {
+	if recorder := recording.Current(); recorder != nil {
+		return recorder.RandStringFromCharSet(strlen, charSet)
+	}
+
 	return acctest.RandStringFromCharSet(strlen, charSet)
 }
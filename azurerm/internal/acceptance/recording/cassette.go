@@ -0,0 +1,96 @@
+package recording
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Cassette contains the requests (and their responses) recorded for a single test, along with
+// the values required to regenerate the same random values when these are replayed
+type Cassette struct {
+	// Seed is used to generate the random values for this test
+	Seed int64 `json:"seed"`
+
+	// Locations are the Azure Regions which were used for this test
+	Locations Locations `json:"locations"`
+
+	// Interactions are the requests sent to Azure (and their responses), in the order they were sent
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Locations are the Azure Regions used for a test
+type Locations struct {
+	Primary   string `json:"primary"`
+	Secondary string `json:"secondary"`
+	Ternary   string `json:"ternary"`
+}
+
+// Interaction is a single request sent to Azure and the response which was returned
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// key returns the key used to match a request against this Interaction when replaying
+func (i Interaction) key() string {
+	return requestKey(i.Request.Method, i.Request.URL)
+}
+
+func requestKey(method, url string) string {
+	return fmt.Sprintf("%s %s", strings.ToUpper(method), url)
+}
+
+// cassettePath returns the path to the Cassette for the specified test - where the
+// separators used for sub-tests are replaced to keep these in a single directory
+func cassettePath(testName string) string {
+	fileName := strings.NewReplacer("/", "_", "\\", "_", " ", "_").Replace(testName)
+	return filepath.Join(directory(), fmt.Sprintf("%s.json", fileName))
+}
+
+func loadCassette(path string) (*Cassette, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(contents, &cassette); err != nil {
+		return nil, fmt.Errorf("parsing Cassette %q: %+v", path, err)
+	}
+
+	return &cassette, nil
+}
+
+func (c Cassette) save(path string) error {
+	contents, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing Cassette: %+v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating directory for Cassette %q: %+v", path, err)
+	}
+
+	if err := ioutil.WriteFile(path, contents, 0644); err != nil {
+		return fmt.Errorf("writing Cassette %q: %+v", path, err)
+	}
+
+	return nil
+}
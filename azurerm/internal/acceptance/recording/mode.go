@@ -0,0 +1,51 @@
+package recording
+
+import (
+	"os"
+	"strings"
+)
+
+// Mode is the mode used to record or replay the requests sent to Azure during the Acceptance Tests
+type Mode string
+
+const (
+	// ModeLive sends requests to Azure without recording them, which is the default
+	ModeLive Mode = ""
+
+	// ModeRecord sends requests to Azure and records these (and their responses) to a Cassette for each test
+	ModeRecord Mode = "record"
+
+	// ModeReplay replays the responses from the Cassette for each test without connecting to Azure
+	ModeReplay Mode = "replay"
+)
+
+const (
+	// modeEnvVar is the Environment Variable used to specify the Mode
+	modeEnvVar = "ARM_TEST_RECORDING_MODE"
+
+	// directoryEnvVar is the Environment Variable used to specify the directory containing
+	// the Cassettes, which defaults to `testdata/recordings` within the package being tested
+	directoryEnvVar = "ARM_TEST_RECORDINGS_DIR"
+
+	defaultDirectory = "testdata/recordings"
+)
+
+// CurrentMode returns the Mode specified via the `ARM_TEST_RECORDING_MODE` Environment Variable
+func CurrentMode() Mode {
+	switch Mode(strings.ToLower(os.Getenv(modeEnvVar))) {
+	case ModeRecord:
+		return ModeRecord
+	case ModeReplay:
+		return ModeReplay
+	}
+
+	return ModeLive
+}
+
+func directory() string {
+	if v := os.Getenv(directoryEnvVar); v != "" {
+		return v
+	}
+
+	return defaultDirectory
+}
@@ -0,0 +1,344 @@
+package recording
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+var (
+	recordersLock sync.Mutex
+
+	// recorders are the Recorders for the tests which are currently running, keyed by test name
+	recorders = map[string]*Recorder{}
+
+	// current is the Recorder for the test which was most recently started
+	current *Recorder
+
+	placeholderEnvironment sync.Once
+)
+
+// Recorder records the requests sent to Azure during a test to a Cassette, or replays these
+// from a previously recorded Cassette - in addition to generating the random values for the test
+type Recorder struct {
+	mode      Mode
+	path      string
+	sanitizer sanitizer
+
+	lock     sync.Mutex
+	cassette *Cassette
+	random   *rand.Rand
+
+	// interactions are the Interactions from the Cassette (keyed by request) when replaying
+	interactions map[string][]Interaction
+
+	// replayed is the number of times each request has been replayed
+	replayed map[string]int
+
+	// requestedPaths are the (lower-cased) URL paths which have been requested when recording
+	requestedPaths map[string]struct{}
+}
+
+// ForTest returns the Recorder for the specified test, creating this if necessary - nil is
+// returned when requests are sent to Azure without being recorded.
+//
+// When recording, the Cassette is saved once the test has completed successfully. When replaying
+// the test is skipped if no Cassette has been recorded for it.
+func ForTest(t *testing.T) *Recorder {
+	mode := CurrentMode()
+	if mode == ModeLive {
+		return nil
+	}
+
+	recordersLock.Lock()
+	defer recordersLock.Unlock()
+
+	if existing, ok := recorders[t.Name()]; ok {
+		return existing
+	}
+
+	recorder := &Recorder{
+		mode:           mode,
+		path:           cassettePath(t.Name()),
+		replayed:       map[string]int{},
+		requestedPaths: map[string]struct{}{},
+	}
+
+	if mode == ModeReplay {
+		placeholderEnvironment.Do(func() {
+			if err := setPlaceholderEnvironment(); err != nil {
+				t.Fatalf("setting the placeholder credentials for replaying: %+v", err)
+			}
+		})
+
+		cassette, err := loadCassette(recorder.path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				t.Skipf("Skipping since no recording exists at %q - run this test with `%s=%s` to record one", recorder.path, modeEnvVar, ModeRecord)
+			}
+			t.Fatalf("loading the recording for %q: %+v", t.Name(), err)
+		}
+		recorder.cassette = cassette
+		recorder.interactions = map[string][]Interaction{}
+		for _, interaction := range cassette.Interactions {
+			key := interaction.key()
+			recorder.interactions[key] = append(recorder.interactions[key], interaction)
+		}
+	} else {
+		recorder.cassette = &Cassette{
+			Seed: time.Now().UnixNano(),
+		}
+	}
+
+	recorder.sanitizer = newSanitizer()
+	recorder.random = rand.New(rand.NewSource(recorder.cassette.Seed)) // nolint:gosec
+
+	recorders[t.Name()] = recorder
+	current = recorder
+
+	t.Cleanup(func() {
+		recordersLock.Lock()
+		delete(recorders, t.Name())
+		if current == recorder {
+			current = nil
+		}
+		recordersLock.Unlock()
+
+		recorder.complete(t)
+	})
+
+	return recorder
+}
+
+// Current returns the Recorder for the test which was most recently started, provided that test
+// hasn't yet started running its steps (at which point it may be running in parallel with others).
+//
+// This allows values generated before the steps are run (for example, random values generated
+// outside of the TestData) to be deterministic - nil is returned when there's no such Recorder.
+func Current() *Recorder {
+	recordersLock.Lock()
+	defer recordersLock.Unlock()
+
+	return current
+}
+
+// ResetCurrent clears the Recorder returned from Current if it's the specified Recorder,
+// which should be called before the steps for the test are run
+func ResetCurrent(recorder *Recorder) {
+	recordersLock.Lock()
+	defer recordersLock.Unlock()
+
+	if current == recorder {
+		current = nil
+	}
+}
+
+// Replaying returns whether the requests are being replayed from a Cassette
+func (r *Recorder) Replaying() bool {
+	return r.mode == ModeReplay
+}
+
+// SendDecorator returns a SendDecorator which either records each request or replays it
+func (r *Recorder) SendDecorator() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+			return r.send(s, req)
+		})
+	}
+}
+
+// Now returns the time used to generate the random values for this test, which is the time
+// this test was recorded
+func (r *Recorder) Now() time.Time {
+	return time.Unix(0, r.cassette.Seed).UTC()
+}
+
+// RandStringFromCharSet generates a random string by selecting characters from the charset provided
+func (r *Recorder) RandStringFromCharSet(strlen int, charSet string) string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	result := make([]byte, strlen)
+	for i := 0; i < strlen; i++ {
+		result[i] = charSet[r.random.Intn(len(charSet))]
+	}
+	return string(result)
+}
+
+// Locations returns the Azure Regions to use for this test - when recording the specified
+// Locations are recorded to the Cassette, when replaying the recorded Locations are returned
+func (r *Recorder) Locations(input Locations) Locations {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.Replaying() {
+		return r.cassette.Locations
+	}
+
+	r.cassette.Locations = input
+	return input
+}
+
+func (r *Recorder) send(s autorest.Sender, req *http.Request) (*http.Response, error) {
+	if r.Replaying() {
+		return r.replay(req)
+	}
+
+	return r.record(s, req)
+}
+
+func (r *Recorder) record(s autorest.Sender, req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading the request body to record: %+v", err)
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		requestBody = body
+	}
+
+	resp, err := s.Do(req)
+	if err != nil {
+		// transport errors aren't recorded, since these are retried
+		return resp, err
+	}
+
+	var responseBody []byte
+	if resp.Body != nil {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return resp, fmt.Errorf("reading the response body to record: %+v", err)
+		}
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		responseBody = body
+	}
+
+	interaction := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    r.sanitizer.sanitizeString(req.URL.String()),
+			Body:   r.sanitizer.sanitizeString(string(requestBody)),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    r.sanitizer.sanitizeHeaders(resp.Header),
+			Body:       r.sanitizer.sanitizeString(string(responseBody)),
+		},
+	}
+
+	r.lock.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.requestedPaths[strings.ToLower(req.URL.Path)] = struct{}{}
+	r.lock.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	key := requestKey(req.Method, r.sanitizer.sanitizeString(req.URL.String()))
+
+	interaction, ok := r.nextInteraction(key)
+	if !ok {
+		return nil, fmt.Errorf("no recorded response was found in %q for %s", r.path, key)
+	}
+
+	headers := r.sanitizer.restoreHeaders(interaction.Response.Headers)
+	if headers.Get(autorest.HeaderRetryAfter) != "" {
+		// there's no need to wait when replaying
+		headers.Set(autorest.HeaderRetryAfter, "0")
+	}
+	body := r.sanitizer.restoreString(interaction.Response.Body)
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// nextInteraction returns the next recorded Interaction for the specified request - once
+// these have all been replayed the last response is returned for any further reads, since
+// Terraform may refresh a resource more times than when it was recorded
+func (r *Recorder) nextInteraction(key string) (*Interaction, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	interactions := r.interactions[key]
+	if len(interactions) == 0 {
+		return nil, false
+	}
+
+	index := r.replayed[key]
+	if index >= len(interactions) {
+		if !strings.HasPrefix(key, http.MethodGet+" ") && !strings.HasPrefix(key, http.MethodHead+" ") {
+			return nil, false
+		}
+		index = len(interactions) - 1
+	}
+	r.replayed[key]++
+
+	return &interactions[index], true
+}
+
+// handles returns whether this Recorder should record/replay the specified request, which
+// is used to determine which test a request sent from a shared client belongs to
+func (r *Recorder) handles(req *http.Request) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.Replaying() {
+		key := requestKey(req.Method, r.sanitizer.sanitizeString(req.URL.String()))
+		_, ok := r.interactions[key]
+		return ok
+	}
+
+	_, ok := r.requestedPaths[strings.ToLower(req.URL.Path)]
+	return ok
+}
+
+// complete saves the Cassette once the test has completed when recording, and when replaying
+// logs any recorded requests which weren't replayed (which may indicate the test has changed)
+func (r *Recorder) complete(t *testing.T) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.Replaying() {
+		unused := 0
+		for key, interactions := range r.interactions {
+			if r.replayed[key] < len(interactions) {
+				unused += len(interactions) - r.replayed[key]
+			}
+		}
+		if unused > 0 {
+			t.Logf("[DEBUG] %d recorded requests in %q were not replayed - this recording may need updating", unused, r.path)
+		}
+		return
+	}
+
+	if t.Failed() {
+		t.Logf("[DEBUG] Not saving the recording to %q since the test failed", r.path)
+		return
+	}
+
+	if err := r.cassette.save(r.path); err != nil {
+		t.Errorf("saving the recording for %q: %+v", t.Name(), err)
+	}
+}
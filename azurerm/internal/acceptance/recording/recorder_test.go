@@ -0,0 +1,194 @@
+package recording
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const testSubscriptionId = "11111111-2222-3333-4444-555555555555"
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	setTestEnvironment(t, ModeRecord)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id":"/subscriptions/` + testSubscriptionId + `/resourceGroups/example"}`)) // nolint:errcheck
+	}))
+	uri := server.URL + "/subscriptions/" + testSubscriptionId + "/resourceGroups/example"
+
+	var recordedValue string
+	t.Run("record", func(t *testing.T) {
+		recorder := ForTest(t)
+		if recorder == nil {
+			t.Fatalf("expected a Recorder but got nil")
+		}
+		recordedValue = recorder.RandStringFromCharSet(10, "abcdef")
+
+		resp := sendTestRequest(t, recorder, http.MethodGet, uri)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected a %d but got %d", http.StatusOK, resp.StatusCode)
+		}
+	})
+	server.Close()
+
+	contents, err := ioutil.ReadFile(cassettePath("TestRecorder_RecordAndReplay/record"))
+	if err != nil {
+		t.Fatalf("reading the recording: %+v", err)
+	}
+	if strings.Contains(string(contents), testSubscriptionId) {
+		t.Fatalf("expected the Subscription ID to be replaced in the recording but got: %s", string(contents))
+	}
+
+	if err := os.Rename(cassettePath("TestRecorder_RecordAndReplay/record"), cassettePath("TestRecorder_RecordAndReplay/replay")); err != nil {
+		t.Fatalf("renaming the recording: %+v", err)
+	}
+
+	setEnv(t, modeEnvVar, string(ModeReplay))
+	t.Run("replay", func(t *testing.T) {
+		recorder := ForTest(t)
+		if !recorder.Replaying() {
+			t.Fatalf("expected the Recorder to be replaying")
+		}
+		if actual := recorder.RandStringFromCharSet(10, "abcdef"); actual != recordedValue {
+			t.Fatalf("expected the random value %q to match the recorded value %q", actual, recordedValue)
+		}
+
+		// the server has been closed, so this must be replayed
+		resp := sendTestRequest(t, recorder, http.MethodGet, uri)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected a %d but got %d", http.StatusOK, resp.StatusCode)
+		}
+		if actual := resp.Header.Get("Retry-After"); actual != "0" {
+			t.Fatalf("expected the Retry-After header to be 0 when replaying but got %q", actual)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		if !strings.Contains(string(body), testSubscriptionId) {
+			t.Fatalf("expected the Subscription ID to be restored in the response but got: %s", string(body))
+		}
+
+		if _, err := recorder.SendDecorator()(nil).Do(newTestRequest(t, http.MethodDelete, uri)); err == nil {
+			t.Fatalf("expected an error for a request which wasn't recorded but didn't get one")
+		}
+	})
+}
+
+func TestRecorder_LiveMode(t *testing.T) {
+	setTestEnvironment(t, ModeLive)
+
+	if recorder := ForTest(t); recorder != nil {
+		t.Fatalf("expected no Recorder when running against Azure")
+	}
+	if recorder := Shared(); recorder != nil {
+		t.Fatalf("expected no shared Recorder when running against Azure")
+	}
+}
+
+func TestRecorder_ReplayRepeatsLastRead(t *testing.T) {
+	recorder := &Recorder{
+		mode:      ModeReplay,
+		cassette:  &Cassette{},
+		sanitizer: newSanitizer(),
+		replayed:  map[string]int{},
+		interactions: map[string][]Interaction{
+			"GET https://example.com/first": {
+				{Response: Response{StatusCode: http.StatusNotFound}},
+				{Response: Response{StatusCode: http.StatusOK}},
+			},
+			"PUT https://example.com/first": {
+				{Response: Response{StatusCode: http.StatusCreated}},
+			},
+		},
+	}
+
+	for i, expected := range []int{http.StatusNotFound, http.StatusOK, http.StatusOK} {
+		resp, err := recorder.replay(newTestRequest(t, http.MethodGet, "https://example.com/first"))
+		if err != nil {
+			t.Fatalf("replaying request %d: %+v", i, err)
+		}
+		if resp.StatusCode != expected {
+			t.Fatalf("expected request %d to return a %d but got %d", i, expected, resp.StatusCode)
+		}
+	}
+
+	if _, err := recorder.replay(newTestRequest(t, http.MethodPut, "https://example.com/first")); err != nil {
+		t.Fatalf("replaying request: %+v", err)
+	}
+	if _, err := recorder.replay(newTestRequest(t, http.MethodPut, "https://example.com/first")); err == nil {
+		t.Fatalf("expected an error when replaying a write more times than it was recorded")
+	}
+}
+
+func TestShared_MatchesRequestToTest(t *testing.T) {
+	setTestEnvironment(t, ModeReplay)
+
+	cassette := Cassette{
+		Interactions: []Interaction{
+			{
+				Request:  Request{Method: http.MethodGet, URL: "https://example.com/other"},
+				Response: Response{StatusCode: http.StatusOK},
+			},
+		},
+	}
+	if err := cassette.save(cassettePath(t.Name())); err != nil {
+		t.Fatalf("saving recording: %+v", err)
+	}
+	ForTest(t)
+
+	sender := Shared().SendDecorator()(nil)
+	resp, err := sender.Do(newTestRequest(t, http.MethodGet, "https://example.com/other"))
+	if err != nil {
+		t.Fatalf("replaying request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a %d but got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	if _, err := sender.Do(newTestRequest(t, http.MethodGet, "https://example.com/unknown")); err == nil {
+		t.Fatalf("expected an error for a request which wasn't recorded by any test")
+	}
+}
+
+func setTestEnvironment(t *testing.T, mode Mode) {
+	setEnv(t, modeEnvVar, string(mode))
+	setEnv(t, directoryEnvVar, t.TempDir())
+	setEnv(t, "ARM_SUBSCRIPTION_ID", testSubscriptionId)
+}
+
+func setEnv(t *testing.T, key, value string) {
+	existing, exists := os.LookupEnv(key)
+	os.Setenv(key, value)
+	t.Cleanup(func() {
+		if exists {
+			os.Setenv(key, existing)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+func newTestRequest(t *testing.T, method, uri string) *http.Request {
+	req, err := http.NewRequest(method, uri, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	return req
+}
+
+func sendTestRequest(t *testing.T, recorder *Recorder, method, uri string) *http.Response {
+	sender := autorest.DecorateSender(http.DefaultClient, recorder.SendDecorator())
+	resp, err := sender.Do(newTestRequest(t, method, uri))
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	t.Cleanup(func() {
+		resp.Body.Close()
+	})
+	return resp
+}
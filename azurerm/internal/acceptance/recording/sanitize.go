@@ -0,0 +1,103 @@
+package recording
+
+import (
+	"net/http"
+	"os"
+	"regexp"
+)
+
+// placeholders are the values used in place of the credentials and identifiers for the tests,
+// allowing the Cassettes to be committed and then replayed without any credentials
+var placeholders = []struct {
+	envVar string
+	value  string
+}{
+	{envVar: "ARM_SUBSCRIPTION_ID", value: "00000000-0000-0000-0000-000000000000"},
+	{envVar: "ARM_SUBSCRIPTION_ID_ALT", value: "00000000-0000-0000-0000-000000000001"},
+	{envVar: "ARM_TENANT_ID", value: "00000000-0000-0000-0000-000000000002"},
+	{envVar: "ARM_CLIENT_ID", value: "00000000-0000-0000-0000-000000000003"},
+	{envVar: "ARM_CLIENT_SECRET", value: "replayed-client-secret"},
+}
+
+type replacement struct {
+	from *regexp.Regexp
+	to   string
+}
+
+// sanitizer replaces the credentials and identifiers used for the tests with placeholders
+// when these are recorded, and the placeholders with these values when replaying
+type sanitizer struct {
+	sanitize []replacement
+	restore  []replacement
+}
+
+func newSanitizer() sanitizer {
+	s := sanitizer{}
+	for _, p := range placeholders {
+		value := os.Getenv(p.envVar)
+		if value == "" || value == p.value {
+			continue
+		}
+
+		s.sanitize = append(s.sanitize, replacement{
+			from: regexp.MustCompile("(?i)" + regexp.QuoteMeta(value)),
+			to:   p.value,
+		})
+		s.restore = append(s.restore, replacement{
+			from: regexp.MustCompile(regexp.QuoteMeta(p.value)),
+			to:   value,
+		})
+	}
+	return s
+}
+
+// sanitizeString replaces any credentials/identifiers in the input with their placeholders
+func (s sanitizer) sanitizeString(input string) string {
+	return replaceAll(input, s.sanitize)
+}
+
+// restoreString replaces any placeholders in the input with the credentials/identifiers for this test run
+func (s sanitizer) restoreString(input string) string {
+	return replaceAll(input, s.restore)
+}
+
+func (s sanitizer) sanitizeHeaders(input http.Header) http.Header {
+	return replaceAllInHeaders(input, s.sanitize)
+}
+
+func (s sanitizer) restoreHeaders(input http.Header) http.Header {
+	return replaceAllInHeaders(input, s.restore)
+}
+
+func replaceAll(input string, replacements []replacement) string {
+	for _, r := range replacements {
+		input = r.from.ReplaceAllLiteralString(input, r.to)
+	}
+	return input
+}
+
+func replaceAllInHeaders(input http.Header, replacements []replacement) http.Header {
+	output := make(http.Header, len(input))
+	for key, values := range input {
+		for _, v := range values {
+			output.Add(key, replaceAll(v, replacements))
+		}
+	}
+	return output
+}
+
+// setPlaceholderEnvironment sets any credentials/identifiers which aren't already set to their
+// placeholder values, such that the Provider can be configured when replaying without credentials
+func setPlaceholderEnvironment() error {
+	for _, p := range placeholders {
+		if os.Getenv(p.envVar) != "" {
+			continue
+		}
+
+		if err := os.Setenv(p.envVar, p.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package recording
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+// sharedRecorder records/replays the requests sent from a client which is shared between tests
+// (for example, the client used to check whether resources exist), using the Recorder for the test
+// which each request belongs to
+type sharedRecorder struct {
+	mode Mode
+}
+
+// Shared returns a RequestRecorder for a client which is shared between tests - nil is returned
+// when requests are sent to Azure without being recorded.
+//
+// Since requests from a shared client can't be tied to a test directly, each request is matched
+// to the running test which either recorded a request to the same resource (when recording) or
+// contains a recording of this request (when replaying). This relies on the names of the resources
+// being unique for each test, which the random values in the TestData ensure.
+func Shared() common.RequestRecorder {
+	mode := CurrentMode()
+	if mode == ModeLive {
+		return nil
+	}
+
+	return sharedRecorder{
+		mode: mode,
+	}
+}
+
+func (s sharedRecorder) Replaying() bool {
+	return s.mode == ModeReplay
+}
+
+func (s sharedRecorder) SendDecorator() autorest.SendDecorator {
+	return func(sender autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+			recorder := recorderForRequest(req)
+			if recorder != nil {
+				return recorder.send(sender, req)
+			}
+
+			if s.Replaying() {
+				return nil, fmt.Errorf("no recorded response was found for %s %s in any of the running tests", req.Method, req.URL)
+			}
+
+			log.Printf("[WARN] Unable to determine which test %s %s belongs to - this request won't be recorded", req.Method, req.URL)
+			return sender.Do(req)
+		})
+	}
+}
+
+func recorderForRequest(req *http.Request) *Recorder {
+	recordersLock.Lock()
+	defer recordersLock.Unlock()
+
+	for _, recorder := range recorders {
+		if recorder.handles(req) {
+			return recorder
+		}
+	}
+
+	return nil
+}
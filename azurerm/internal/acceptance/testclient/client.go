@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
@@ -48,6 +49,9 @@ func Build() (*clients.Client, error) {
 			TerraformVersion:         os.Getenv("TERRAFORM_CORE_VERSION"),
 			Features:                 features.Default(),
 			StorageUseAzureAD:        false,
+
+			// this client is shared between tests, so the requests are matched to each test
+			Recorder: recording.Shared(),
 		}
 		client, err := clients.Build(context.TODO(), clientBuilder)
 		if err != nil {
@@ -20,8 +20,12 @@ type ClientBuilder struct {
 	AuthConfig                  *authentication.Config
 	DisableCorrelationRequestID bool
 	CustomCorrelationRequestID  string
+	DefaultTags                 map[string]interface{}
 	DisableTerraformPartnerID   bool
 	PartnerId                   string
+	RateLimit                   *common.RateLimit
+	Recorder                    common.RequestRecorder
+	RetryPolicy                 *common.RetryPolicy
 	SkipProviderRegistration    bool
 	StorageUseAzureAD           bool
 	TerraformVersion            string
@@ -56,14 +60,23 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf("unable to find environment %q from endpoint %q: %+v", builder.AuthConfig.Environment, builder.AuthConfig.MetadataHost, err)
 	}
 
+	replaying := builder.Recorder != nil && builder.Recorder.Replaying()
+
+	authConfig := *builder.AuthConfig
+	if replaying {
+		// looking up the Object ID requires connecting to Azure
+		authConfig.GetAuthenticatedObjectID = nil
+	}
+
 	// client declarations:
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, *env, builder.SkipProviderRegistration)
+	account, err := NewResourceManagerAccount(ctx, authConfig, *env, builder.SkipProviderRegistration)
 	if err != nil {
 		return nil, fmt.Errorf("Error building account: %+v", err)
 	}
 
 	client := Client{
-		Account: account,
+		Account:     account,
+		DefaultTags: builder.DefaultTags,
 	}
 
 	oauthConfig, err := builder.AuthConfig.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
@@ -131,13 +144,16 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		Environment:                 *env,
 		Features:                    builder.Features,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		RetryPolicy:                 builder.RetryPolicy,
+		RateLimit:                   builder.RateLimit,
+		Recorder:                    builder.Recorder,
 	}
 
 	if err := client.Build(ctx, o); err != nil {
 		return nil, fmt.Errorf("error building Client: %+v", err)
 	}
 
-	if features.EnhancedValidationEnabled() {
+	if features.EnhancedValidationEnabled() && !replaying {
 		location.CacheSupportedLocations(ctx, env)
 		resourceproviders.CacheSupportedProviders(ctx, client.Resource.ProvidersClient)
 	}
@@ -108,6 +108,14 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// CorrelationRequestID is the value sent in the `x-ms-correlation-request-id` header
+	// for each request to Azure, which is empty when this has been disabled
+	CorrelationRequestID string
+
+	// DefaultTags are the Tags specified in the Provider block which are assigned to each Resource
+	// using the Tags Schema, in addition to the Tags specified on the Resource
+	DefaultTags map[string]interface{}
+
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisServices.Client
 	ApiManagement         *apiManagement.Client
@@ -211,6 +219,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 
 	client.Features = o.Features
 	client.StopContext = ctx
+	client.CorrelationRequestID = o.CorrelationRequestID()
 
 	client.Advisor = advisor.NewClient(o)
 	client.AnalysisServices = analysisServices.NewClient(o)
@@ -34,6 +34,18 @@ type ClientOptions struct {
 	Environment                 azure.Environment
 	Features                    features.UserFeatures
 	StorageUseAzureAD           bool
+
+	// RetryPolicy (optional) configures how requests are retried when they're throttled or
+	// fail with a transient error - when not specified the Azure SDK's behaviour is used
+	RetryPolicy *RetryPolicy
+
+	// RateLimit (optional) configures client-side limits for requests to Azure Resource Manager,
+	// which are shared across all clients for this Tenant and Subscription
+	RateLimit *RateLimit
+
+	// Recorder (optional) is used by the Acceptance Tests to record the requests sent to Azure,
+	// or to replay previously recorded requests rather than sending these to Azure
+	Recorder RequestRecorder
 }
 
 func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.Authorizer) {
@@ -41,16 +53,50 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 
 	c.Authorizer = authorizer
 	c.Sender = sender.BuildSender("AzureRM")
-	c.SkipResourceProviderRegistration = o.SkipProviderReg
-	if !o.DisableCorrelationRequestID {
-		id := o.CustomCorrelationRequestID
-		if id == "" {
-			id = correlationRequestID()
+	if o.Recorder != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, o.Recorder.SendDecorator())
+		if o.Recorder.Replaying() {
+			// there's nothing to wait for when replaying requests
+			c.Authorizer = autorest.NullAuthorizer{}
+			c.PollingDelay = 0
+			c.RetryDuration = 0
 		}
+	}
+	if o.RateLimit != nil && o.RateLimit.enabled() {
+		limiter := rateLimiterFor(o.TenantID, o.SubscriptionId, *o.RateLimit)
+		c.Sender = autorest.DecorateSender(c.Sender, withRateLimit(limiter, o.ResourceManagerEndpoint))
+	}
+	// NOTE: the Retry Policy replaces the retry logic which the Azure SDK uses for this client, rather than
+	// changing the Azure SDK's defaults (which are shared by every client in this process). Resource Providers
+	// are registered when the Provider is configured, so the Azure SDK's automatic registration isn't needed.
+	// Since these decorate the client, the Retry Policy wraps the Rate Limit so that each retry is also subject
+	// to the Rate Limit.
+	if o.RetryPolicy != nil {
+		c.SendDecorators = []autorest.SendDecorator{
+			withRetryPolicy(*o.RetryPolicy),
+		}
+	}
+	c.SkipResourceProviderRegistration = o.SkipProviderReg
+	if id := o.CorrelationRequestID(); id != "" {
 		c.RequestInspector = withCorrelationRequestID(id)
 	}
 }
 
+// CorrelationRequestID returns the value sent in the `x-ms-correlation-request-id` header
+// for each request - this is either user-specified or generated once per provider process.
+// An empty string is returned when sending the Correlation Request ID has been disabled.
+func (o ClientOptions) CorrelationRequestID() string {
+	if o.DisableCorrelationRequestID {
+		return ""
+	}
+
+	if o.CustomCorrelationRequestID != "" {
+		return o.CustomCorrelationRequestID
+	}
+
+	return correlationRequestID()
+}
+
 func setUserAgent(client *autorest.Client, tfVersion, partnerID string, disableTerraformPartnerID bool) {
 	tfUserAgent := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io) Terraform Plugin SDK/%s", tfVersion, meta.SDKVersionString())
 
@@ -29,3 +29,17 @@ func TestWithCorrelationRequestID(t *testing.T) {
 			HeaderCorrelationRequestID, uuid, req.Header.Get(HeaderCorrelationRequestID))
 	}
 }
+
+func TestClientOptionsCorrelationRequestID(t *testing.T) {
+	if actual := (ClientOptions{DisableCorrelationRequestID: true}).CorrelationRequestID(); actual != "" {
+		t.Fatalf("expected no correlation request ID when disabled but got %q", actual)
+	}
+
+	if actual := (ClientOptions{CustomCorrelationRequestID: "custom"}).CorrelationRequestID(); actual != "custom" {
+		t.Fatalf("expected the custom correlation request ID but got %q", actual)
+	}
+
+	if actual := (ClientOptions{}).CorrelationRequestID(); actual != correlationRequestID() {
+		t.Fatalf("expected the generated correlation request ID but got %q", actual)
+	}
+}
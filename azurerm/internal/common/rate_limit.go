@@ -0,0 +1,194 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// RateLimit configures client-side limits for the requests made to Azure Resource Manager,
+// which are shared by all of the clients for a given Tenant and Subscription
+type RateLimit struct {
+	// ReadsPerSecond is the sustained number of read (GET/HEAD) requests which can be made
+	// each second, where 0 means the number of read requests isn't limited
+	ReadsPerSecond float64
+
+	// ReadBurst is the number of read requests which can be made at once, before being limited
+	// to ReadsPerSecond - when not specified this defaults to 1
+	ReadBurst int
+
+	// MaxConcurrentRequests is the maximum number of requests which can be in-flight at once,
+	// where 0 means the number of concurrent requests isn't limited
+	MaxConcurrentRequests int
+}
+
+func (r RateLimit) enabled() bool {
+	return r.ReadsPerSecond > 0 || r.MaxConcurrentRequests > 0
+}
+
+var (
+	rateLimitersLock sync.Mutex
+	rateLimiters     = map[string]*rateLimiter{}
+)
+
+// rateLimiter limits the requests made to Azure Resource Manager for a given Tenant and Subscription
+type rateLimiter struct {
+	config RateLimit
+	reads  *tokenBucket
+
+	// requests is a semaphore limiting the number of concurrent requests, which is nil when unlimited
+	requests chan struct{}
+}
+
+// rateLimiterFor returns the rateLimiter shared by all clients for the specified Tenant and Subscription,
+// creating this if necessary. Where the configuration differs from the existing rateLimiter (for example
+// when multiple Provider blocks are used for the same Subscription) the existing configuration is used.
+func rateLimiterFor(tenantId, subscriptionId string, config RateLimit) *rateLimiter {
+	key := fmt.Sprintf("%s/%s", strings.ToLower(tenantId), strings.ToLower(subscriptionId))
+
+	rateLimitersLock.Lock()
+	defer rateLimitersLock.Unlock()
+
+	if existing, ok := rateLimiters[key]; ok {
+		if existing.config != config {
+			log.Printf("[WARN] A different Rate Limit has already been configured for Subscription %q (Tenant %q) - using %+v", subscriptionId, tenantId, existing.config)
+		}
+		return existing
+	}
+
+	limiter := newRateLimiter(config)
+	rateLimiters[key] = limiter
+	return limiter
+}
+
+func newRateLimiter(config RateLimit) *rateLimiter {
+	limiter := rateLimiter{
+		config: config,
+	}
+	if config.ReadsPerSecond > 0 {
+		burst := config.ReadBurst
+		if burst <= 0 {
+			burst = 1
+		}
+		limiter.reads = newTokenBucket(config.ReadsPerSecond, burst)
+	}
+	if config.MaxConcurrentRequests > 0 {
+		limiter.requests = make(chan struct{}, config.MaxConcurrentRequests)
+	}
+	return &limiter
+}
+
+// withRateLimit returns a SendDecorator which limits the requests made to the specified endpoint
+// (that is, Azure Resource Manager) - requests made to other endpoints (e.g. Data Plane API's) aren't limited
+func withRateLimit(limiter *rateLimiter, endpoint string) autorest.SendDecorator {
+	host := ""
+	if endpoint != "" {
+		if u, err := url.Parse(endpoint); err == nil {
+			host = u.Host
+		}
+	}
+
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if host != "" && !strings.EqualFold(r.URL.Host, host) {
+				return s.Do(r)
+			}
+
+			release, err := limiter.acquire(r.Context(), r.Method)
+			if err != nil {
+				return nil, fmt.Errorf("waiting to send request to %s: %+v", r.URL, err)
+			}
+			defer release()
+
+			return s.Do(r)
+		})
+	}
+}
+
+// acquire waits until a request using the specified HTTP Method can be made, returning a function
+// which must be called once the request has completed
+func (l *rateLimiter) acquire(ctx context.Context, method string) (func(), error) {
+	if l.reads != nil && (method == http.MethodGet || method == http.MethodHead) {
+		if err := l.reads.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if l.requests == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.requests <- struct{}{}:
+		return func() {
+			<-l.requests
+		}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// tokenBucket is a token bucket which is refilled at `rate` tokens per second, up to `burst` tokens
+type tokenBucket struct {
+	lock   sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until a token is available (consuming it) or the Context is cancelled
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		delay := b.take()
+		if delay == 0 {
+			return nil
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// take consumes a token if one is available, otherwise returns how long until one will be
+func (b *tokenBucket) take() time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+
+	delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+	if delay <= 0 {
+		delay = time.Millisecond
+	}
+	return delay
+}
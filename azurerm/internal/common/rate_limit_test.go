@@ -0,0 +1,161 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRateLimit_ReadsArePerSecond(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	limiter := newRateLimiter(RateLimit{
+		ReadsPerSecond: 20,
+		ReadBurst:      2,
+	})
+	sender := autorest.DecorateSender(http.DefaultClient, withRateLimit(limiter, server.URL))
+
+	// the first 2 requests are allowed by the burst, the remaining 4 are limited to 20/s
+	started := time.Now()
+	for i := 0; i < 6; i++ {
+		sendRateLimitedRequest(t, sender, http.MethodGet, server.URL)
+	}
+	if elapsed := time.Since(started); elapsed < 190*time.Millisecond {
+		t.Fatalf("expected the read requests to be rate limited but these completed in %s", elapsed)
+	}
+}
+
+func TestRateLimit_WritesAreNotRateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	limiter := newRateLimiter(RateLimit{
+		ReadsPerSecond: 1,
+	})
+	sender := autorest.DecorateSender(http.DefaultClient, withRateLimit(limiter, server.URL))
+
+	started := time.Now()
+	for i := 0; i < 5; i++ {
+		sendRateLimitedRequest(t, sender, http.MethodPut, server.URL)
+	}
+	if elapsed := time.Since(started); elapsed > 500*time.Millisecond {
+		t.Fatalf("expected the write requests not to be rate limited but these took %s", elapsed)
+	}
+}
+
+func TestRateLimit_OtherEndpointsAreNotRateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	limiter := newRateLimiter(RateLimit{
+		ReadsPerSecond: 1,
+	})
+	sender := autorest.DecorateSender(http.DefaultClient, withRateLimit(limiter, "https://management.azure.com/"))
+
+	started := time.Now()
+	for i := 0; i < 5; i++ {
+		sendRateLimitedRequest(t, sender, http.MethodGet, server.URL)
+	}
+	if elapsed := time.Since(started); elapsed > 500*time.Millisecond {
+		t.Fatalf("expected requests to other endpoints not to be rate limited but these took %s", elapsed)
+	}
+}
+
+func TestRateLimit_MaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			existing := atomic.LoadInt32(&maxInFlight)
+			if current <= existing || atomic.CompareAndSwapInt32(&maxInFlight, existing, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	limiter := newRateLimiter(RateLimit{
+		MaxConcurrentRequests: 2,
+	})
+	sender := autorest.DecorateSender(http.DefaultClient, withRateLimit(limiter, server.URL))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sendRateLimitedRequest(t, sender, http.MethodGet, server.URL)
+		}()
+	}
+	wg.Wait()
+
+	if actual := atomic.LoadInt32(&maxInFlight); actual > 2 {
+		t.Fatalf("expected at most 2 concurrent requests but got %d", actual)
+	}
+}
+
+func TestRateLimit_ContextCancelled(t *testing.T) {
+	limiter := newRateLimiter(RateLimit{
+		ReadsPerSecond: 0.1,
+	})
+
+	// the first request uses the burst
+	release, err := limiter.acquire(context.TODO(), http.MethodGet)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	release()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.acquire(ctx, http.MethodGet); err == nil {
+		t.Fatalf("expected an error when the context is cancelled but didn't get one")
+	}
+}
+
+func TestRateLimit_SharedPerSubscription(t *testing.T) {
+	config := RateLimit{
+		ReadsPerSecond: 10,
+	}
+	first := rateLimiterFor("tenant1", "subscription1", config)
+	second := rateLimiterFor("TENANT1", "subscription1", config)
+	if first != second {
+		t.Fatalf("expected the same rate limiter to be used for the same Tenant and Subscription")
+	}
+
+	other := rateLimiterFor("tenant1", "subscription2", config)
+	if first == other {
+		t.Fatalf("expected a different rate limiter to be used for a different Subscription")
+	}
+}
+
+func sendRateLimitedRequest(t *testing.T, sender autorest.Sender, method, url string) {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		t.Errorf("building request: %+v", err)
+		return
+	}
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Errorf("sending request: %+v", err)
+		return
+	}
+	resp.Body.Close()
+}
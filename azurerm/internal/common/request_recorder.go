@@ -0,0 +1,14 @@
+package common
+
+import "github.com/Azure/go-autorest/autorest"
+
+// RequestRecorder allows the requests sent to Azure to be recorded, or previously recorded
+// requests to be replayed - which is used to run the Acceptance Tests without connecting to Azure
+type RequestRecorder interface {
+	// SendDecorator returns a SendDecorator which records or replays each request
+	SendDecorator() autorest.SendDecorator
+
+	// Replaying returns whether previously recorded requests are being replayed, in which
+	// case no requests are sent to Azure and as such no authorization is required
+	Replaying() bool
+}
@@ -0,0 +1,186 @@
+package common
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// DefaultMaxRetries is the default number of times a request failing with a transient error is retried
+	DefaultMaxRetries = 3
+
+	// DefaultMaxThrottledRetries is the default number of times a throttled request is retried
+	DefaultMaxThrottledRetries = 10
+
+	// DefaultRetryBackoff is the default initial delay between retries, which matches the Azure SDK
+	DefaultRetryBackoff = 30 * time.Second
+
+	// DefaultRetryMaxBackoff is the default maximum delay between retries
+	DefaultRetryMaxBackoff = 5 * time.Minute
+
+	// headerRateLimitRemainingPrefix is the prefix for the headers returned by Azure Resource Manager
+	// containing the remaining number of requests for the current window, for example
+	// `x-ms-ratelimit-remaining-subscription-reads`
+	headerRateLimitRemainingPrefix = "X-Ms-Ratelimit-Remaining-"
+)
+
+// retryableStatusCodes are the HTTP Status Codes which indicate a request was throttled or hit a transient error
+var retryableStatusCodes = []int{
+	http.StatusRequestTimeout,      // 408
+	http.StatusTooManyRequests,     // 429
+	http.StatusInternalServerError, // 500
+	http.StatusBadGateway,          // 502
+	http.StatusServiceUnavailable,  // 503
+	http.StatusGatewayTimeout,      // 504
+}
+
+// RetryPolicy configures how requests to Azure are retried when they're throttled (HTTP 429)
+// or fail with a transient error (HTTP 408/5xx)
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request failing with a transient error is retried.
+	// Throttled requests don't count towards this, and are instead limited by MaxThrottledRetries.
+	MaxRetries int
+
+	// MaxThrottledRetries is the maximum number of times a throttled request is retried - these are
+	// retried more often than transient errors since Azure specifies how long to wait (via the
+	// `Retry-After` header), but are capped so that a request isn't retried indefinitely
+	MaxThrottledRetries int
+
+	// Backoff is the initial delay between retries, which is doubled for each subsequent retry
+	Backoff time.Duration
+
+	// MaxBackoff is the maximum delay between retries, unless Azure specifies otherwise
+	// via the `Retry-After` header
+	MaxBackoff time.Duration
+
+	// DisableRetryAfter specifies that the `Retry-After` header returned from Azure should be
+	// ignored, using the exponential backoff instead
+	DisableRetryAfter bool
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used when nothing has been configured
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:          DefaultMaxRetries,
+		MaxThrottledRetries: DefaultMaxThrottledRetries,
+		Backoff:             DefaultRetryBackoff,
+		MaxBackoff:          DefaultRetryMaxBackoff,
+	}
+}
+
+// withRetryPolicy returns a SendDecorator which retries requests which are throttled or
+// fail with a transient error, as defined by the specified RetryPolicy
+func withRetryPolicy(policy RetryPolicy) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (resp *http.Response, err error) {
+			rr := autorest.NewRetriableRequest(r)
+			retries := 0
+			throttledRetries := 0
+			for attempt := 0; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+
+				autorest.DrainResponseBody(resp)
+				resp, err = s.Do(rr.Request())
+				if err != nil || !autorest.ResponseHasStatusCode(resp, retryableStatusCodes...) {
+					// transport errors are retried by the Azure SDK
+					return resp, err
+				}
+
+				if resp.StatusCode == http.StatusTooManyRequests {
+					if throttledRetries >= policy.MaxThrottledRetries {
+						log.Printf("[DEBUG] Request to %s was throttled - giving up after %d retries", r.URL, throttledRetries)
+						return resp, err
+					}
+					throttledRetries++
+				} else {
+					if retries >= policy.MaxRetries {
+						log.Printf("[DEBUG] Request to %s returned %d - giving up after %d retries", r.URL, resp.StatusCode, retries)
+						return resp, err
+					}
+					retries++
+				}
+
+				delay := policy.delayForResponse(resp, attempt)
+				log.Printf("[DEBUG] Request to %s returned %d - retrying in %s", r.URL, resp.StatusCode, delay)
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return resp, fmt.Errorf("waiting to retry request to %s: %+v", r.URL, r.Context().Err())
+				}
+			}
+		})
+	}
+}
+
+// delayForResponse returns how long to wait before retrying the request which returned
+// the specified response - where attempt is the zero-based number of the previous attempt
+func (p RetryPolicy) delayForResponse(resp *http.Response, attempt int) time.Duration {
+	if !p.DisableRetryAfter {
+		if delay := retryAfter(resp); delay > 0 {
+			return delay
+		}
+	}
+
+	// when the remaining requests in the current window have been exhausted there's
+	// little point in retrying quickly, so wait for the maximum duration instead
+	if p.MaxBackoff > 0 && rateLimitExhausted(resp) {
+		return p.MaxBackoff
+	}
+
+	delay := p.Backoff
+	for i := 0; i < attempt; i++ {
+		delay *= 2
+		if p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
+
+// retryAfter returns the duration specified in the `Retry-After` header, which is either
+// a number of seconds or a date in RFC1123 format
+func retryAfter(resp *http.Response) time.Duration {
+	raw := resp.Header.Get("Retry-After")
+	if raw == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(raw); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := http.ParseTime(raw); err == nil {
+		return time.Until(t)
+	}
+
+	return 0
+}
+
+// rateLimitExhausted returns whether any of the `x-ms-ratelimit-remaining-*` headers returned
+// by Azure Resource Manager show that no requests remain for the current window
+func rateLimitExhausted(resp *http.Response) bool {
+	for key, values := range resp.Header {
+		if !strings.HasPrefix(http.CanonicalHeaderKey(key), headerRateLimitRemainingPrefix) {
+			continue
+		}
+
+		for _, value := range values {
+			if remaining, err := strconv.Atoi(value); err == nil && remaining <= 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,370 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRetryPolicy_DelayForResponse(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries: 3,
+		Backoff:    1 * time.Second,
+		MaxBackoff: 10 * time.Second,
+	}
+
+	testData := []struct {
+		Name     string
+		Policy   RetryPolicy
+		Headers  map[string]string
+		Attempt  int
+		Expected time.Duration
+	}{
+		{
+			Name:     "First Attempt",
+			Policy:   policy,
+			Attempt:  0,
+			Expected: 1 * time.Second,
+		},
+		{
+			Name:     "Third Attempt",
+			Policy:   policy,
+			Attempt:  2,
+			Expected: 4 * time.Second,
+		},
+		{
+			Name:     "Capped at the Maximum",
+			Policy:   policy,
+			Attempt:  10,
+			Expected: 10 * time.Second,
+		},
+		{
+			Name:   "Retry-After Header",
+			Policy: policy,
+			Headers: map[string]string{
+				"Retry-After": "17",
+			},
+			Attempt:  0,
+			Expected: 17 * time.Second,
+		},
+		{
+			Name: "Retry-After Header Disabled",
+			Policy: RetryPolicy{
+				Backoff:           1 * time.Second,
+				MaxBackoff:        10 * time.Second,
+				DisableRetryAfter: true,
+			},
+			Headers: map[string]string{
+				"Retry-After": "17",
+			},
+			Attempt:  1,
+			Expected: 2 * time.Second,
+		},
+		{
+			Name:   "Invalid Retry-After Header",
+			Policy: policy,
+			Headers: map[string]string{
+				"Retry-After": "soon",
+			},
+			Attempt:  0,
+			Expected: 1 * time.Second,
+		},
+		{
+			Name:   "Rate Limit Remaining",
+			Policy: policy,
+			Headers: map[string]string{
+				"x-ms-ratelimit-remaining-subscription-reads": "11999",
+			},
+			Attempt:  0,
+			Expected: 1 * time.Second,
+		},
+		{
+			Name:   "Rate Limit Exhausted",
+			Policy: policy,
+			Headers: map[string]string{
+				"x-ms-ratelimit-remaining-subscription-reads": "0",
+			},
+			Attempt:  0,
+			Expected: 10 * time.Second,
+		},
+		{
+			Name:   "Rate Limit Exhausted with Retry-After",
+			Policy: policy,
+			Headers: map[string]string{
+				"Retry-After":                            "3",
+				"x-ms-ratelimit-remaining-tenant-writes": "0",
+			},
+			Attempt:  0,
+			Expected: 3 * time.Second,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		resp := &http.Response{
+			Header: http.Header{},
+		}
+		for key, value := range v.Headers {
+			resp.Header.Set(key, value)
+		}
+
+		actual := v.Policy.delayForResponse(resp, v.Attempt)
+		if actual != v.Expected {
+			t.Fatalf("expected a delay of %s but got %s", v.Expected, actual)
+		}
+	}
+}
+
+func TestRetryPolicy_RetriesTransientErrors(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	resp := sendWithRetryPolicy(t, context.TODO(), server.URL, RetryPolicy{
+		MaxRetries: 3,
+		Backoff:    1 * time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+	})
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a %d but got %d", http.StatusOK, resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(&requests); actual != 3 {
+		t.Fatalf("expected 3 requests but got %d", actual)
+	}
+}
+
+func TestRetryPolicy_GivesUpAfterMaxRetries(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	resp := sendWithRetryPolicy(t, context.TODO(), server.URL, RetryPolicy{
+		MaxRetries: 2,
+		Backoff:    1 * time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+	})
+
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected a %d but got %d", http.StatusInternalServerError, resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(&requests); actual != 3 {
+		t.Fatalf("expected 3 requests (1 request and 2 retries) but got %d", actual)
+	}
+}
+
+func TestRetryPolicy_DoesNotRetryOtherErrors(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	resp := sendWithRetryPolicy(t, context.TODO(), server.URL, RetryPolicy{
+		MaxRetries: 3,
+		Backoff:    1 * time.Millisecond,
+	})
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected a %d but got %d", http.StatusBadRequest, resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(&requests); actual != 1 {
+		t.Fatalf("expected 1 request but got %d", actual)
+	}
+}
+
+func TestRetryPolicy_ThrottledRequestsHonourRetryAfter(t *testing.T) {
+	var requests int32
+	var firstRequest time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			firstRequest = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// throttled requests don't count towards the MaxRetries
+	resp := sendWithRetryPolicy(t, context.TODO(), server.URL, RetryPolicy{
+		MaxRetries:          0,
+		MaxThrottledRetries: 1,
+		Backoff:             1 * time.Millisecond,
+		MaxBackoff:          5 * time.Millisecond,
+	})
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a %d but got %d", http.StatusOK, resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(&requests); actual != 2 {
+		t.Fatalf("expected 2 requests but got %d", actual)
+	}
+	if elapsed := time.Since(firstRequest); elapsed < 1*time.Second {
+		t.Fatalf("expected the retry to wait for the Retry-After duration but retried after %s", elapsed)
+	}
+}
+
+func TestRetryPolicy_ThrottledRequestsStopWhenContextCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(http.DefaultClient, withRetryPolicy(RetryPolicy{
+		MaxThrottledRetries: 100,
+		Backoff:             10 * time.Millisecond,
+		MaxBackoff:          10 * time.Millisecond,
+	}))
+	if _, err := sender.Do(req); err == nil {
+		t.Fatalf("expected an error once the context was cancelled but didn't get one")
+	}
+}
+
+func TestRetryPolicy_GivesUpAfterMaxThrottledRetries(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	resp := sendWithRetryPolicy(t, context.TODO(), server.URL, RetryPolicy{
+		MaxRetries:          0,
+		MaxThrottledRetries: 2,
+		Backoff:             1 * time.Millisecond,
+		MaxBackoff:          5 * time.Millisecond,
+	})
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected a %d but got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(&requests); actual != 3 {
+		t.Fatalf("expected 3 requests (1 request and 2 retries) but got %d", actual)
+	}
+}
+
+func TestConfigureClientUsesRetryPolicy(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	options := ClientOptions{
+		DisableCorrelationRequestID: true,
+		RetryPolicy: &RetryPolicy{
+			MaxRetries: 1,
+			Backoff:    1 * time.Millisecond,
+		},
+	}
+	client := autorest.NewClientWithUserAgent("")
+	options.ConfigureClient(&client, autorest.NullAuthorizer{})
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	// the Azure SDK specifies its own retry logic when sending requests, which the Retry Policy replaces
+	resp, err := client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a %d but got %d", http.StatusOK, resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(&requests); actual != 2 {
+		t.Fatalf("expected 2 requests but got %d", actual)
+	}
+}
+
+func TestConfigureClientRetryPolicyCapsThrottledRetries(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	statusCodesForRetry := append([]int{}, autorest.StatusCodesForRetry...)
+	options := ClientOptions{
+		DisableCorrelationRequestID: true,
+		RetryPolicy: &RetryPolicy{
+			MaxThrottledRetries: 2,
+			Backoff:             1 * time.Millisecond,
+			MaxBackoff:          1 * time.Millisecond,
+		},
+	}
+	client := autorest.NewClientWithUserAgent("")
+	options.ConfigureClient(&client, autorest.NullAuthorizer{})
+
+	if !reflect.DeepEqual(autorest.StatusCodesForRetry, statusCodesForRetry) {
+		t.Fatalf("expected the Azure SDK's default Status Codes for Retry to be unchanged but got %+v", autorest.StatusCodesForRetry)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	resp, err := client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected a %d but got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(&requests); actual != 3 {
+		t.Fatalf("expected 3 requests (1 request and 2 retries) but got %d", actual)
+	}
+}
+
+func sendWithRetryPolicy(t *testing.T, ctx context.Context, url string, policy RetryPolicy) *http.Response {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(http.DefaultClient, withRetryPolicy(policy))
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	t.Cleanup(func() {
+		resp.Body.Close()
+	})
+	return resp
+}
@@ -1,5 +1,7 @@
 package locks
 
+import "sort"
+
 // Remove duplicates from the input array and return unify array (without duplicated elements)
 func removeDuplicatesFromStringArray(elements []string) []string {
 	visited := map[string]bool{}
@@ -14,3 +16,10 @@ func removeDuplicatesFromStringArray(elements []string) []string {
 
 	return result
 }
+
+// sortedUniqueStrings returns a sorted copy of the input array without any duplicated elements
+func sortedUniqueStrings(elements []string) []string {
+	result := removeDuplicatesFromStringArray(elements)
+	sort.Strings(result)
+	return result
+}
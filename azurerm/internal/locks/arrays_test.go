@@ -36,3 +36,35 @@ func TestRemoveDuplicatesInStringArray(t *testing.T) {
 		})
 	}
 }
+
+func TestSortedUniqueStrings(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Input  []string
+		Result []string
+	}{
+		{
+			Name:   "contain duplicates",
+			Input:  []string{"string3", "string1", "string2", "string1"},
+			Result: []string{"string1", "string2", "string3"},
+		},
+		{
+			Name:   "already sorted",
+			Input:  []string{"string1", "string2", "string3"},
+			Result: []string{"string1", "string2", "string3"},
+		},
+		{
+			Name:   "empty array",
+			Input:  []string{},
+			Result: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if !reflect.DeepEqual(sortedUniqueStrings(tc.Input), tc.Result) {
+				t.Fatalf("Expected sortedUniqueStrings to return %v", tc.Result)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package locks
 
+import "context"
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = NewMutexKV()
 
@@ -7,20 +9,54 @@ func ByID(id string) {
 	armMutexKV.Lock(id)
 }
 
+// ByIDWithContext locks the specified ID, returning an error if the Context is
+// cancelled or times out before the lock is acquired
+func ByIDWithContext(ctx context.Context, id string) error {
+	return armMutexKV.LockWithContext(ctx, id)
+}
+
 // handle the case of using the same name for different kinds of resources
 func ByName(name string, resourceType string) {
 	updatedName := resourceType + "." + name
 	armMutexKV.Lock(updatedName)
 }
 
+// ByNameWithContext locks the specified name for this resource type, returning an error
+// if the Context is cancelled or times out before the lock is acquired
+func ByNameWithContext(ctx context.Context, name string, resourceType string) error {
+	updatedName := resourceType + "." + name
+	return armMutexKV.LockWithContext(ctx, updatedName)
+}
+
+// MultipleByName locks each of the specified names for this resource type - these are
+// acquired in a consistent (sorted) order regardless of the order they're specified in,
+// to avoid a deadlock when multiple resources lock an overlapping set of names
 func MultipleByName(names *[]string, resourceType string) {
-	newSlice := removeDuplicatesFromStringArray(*names)
+	newSlice := sortedUniqueStrings(*names)
 
 	for _, name := range newSlice {
 		ByName(name, resourceType)
 	}
 }
 
+// MultipleByNameWithContext locks each of the specified names for this resource type in a
+// consistent (sorted) order - returning an error if the Context is cancelled or times out
+// before all of the locks are acquired, in which case any locks acquired are released
+func MultipleByNameWithContext(ctx context.Context, names *[]string, resourceType string) error {
+	newSlice := sortedUniqueStrings(*names)
+
+	for i, name := range newSlice {
+		if err := ByNameWithContext(ctx, name, resourceType); err != nil {
+			for j := i - 1; j >= 0; j-- {
+				UnlockByName(newSlice[j], resourceType)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func UnlockByID(id string) {
 	armMutexKV.Unlock(id)
 }
@@ -31,9 +67,10 @@ func UnlockByName(name string, resourceType string) {
 }
 
 func UnlockMultipleByName(names *[]string, resourceType string) {
-	newSlice := removeDuplicatesFromStringArray(*names)
+	newSlice := sortedUniqueStrings(*names)
 
-	for _, name := range newSlice {
-		UnlockByName(name, resourceType)
+	// release these in the reverse order to which they were acquired
+	for i := len(newSlice) - 1; i >= 0; i-- {
+		UnlockByName(newSlice[i], resourceType)
 	}
 }
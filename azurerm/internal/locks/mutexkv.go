@@ -1,48 +1,164 @@
 package locks
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 )
 
+// defaultWaitLogInterval is how often a message is logged whilst waiting to acquire a lock
+const defaultWaitLogInterval = 1 * time.Minute
+
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*mutexEntry
+
+	// waitLogInterval is how often a message is logged whilst waiting to acquire a lock
+	waitLogInterval time.Duration
+}
+
+// mutexEntry is a mutex which can be acquired with a Context, along with some metadata
+// about its current state for debugging purposes - which is guarded by mutexKV.lock
+type mutexEntry struct {
+	// ch is buffered with a capacity of 1, a value being present means it's locked
+	ch chan struct{}
+
+	lockedAt time.Time
+	waiting  int
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
+	// a Background context is never cancelled, so this can't return an error
+	_ = m.LockWithContext(context.Background(), key)
+}
+
+// LockWithContext locks the mutex for the given key, returning an error if the
+// Context is cancelled or times out before the lock is acquired. Caller is
+// responsible for calling Unlock for the same key when this returns no error.
+func (m *mutexKV) LockWithContext(ctx context.Context, key string) error {
 	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	entry := m.waitFor(key)
+	defer m.doneWaitingFor(entry)
+
+	ticker := time.NewTicker(m.waitLogInterval)
+	defer ticker.Stop()
+
+	started := time.Now()
+	for {
+		select {
+		case entry.ch <- struct{}{}:
+			m.lock.Lock()
+			entry.lockedAt = time.Now()
+			m.lock.Unlock()
+
+			log.Printf("[DEBUG] Locked %q", key)
+			return nil
+
+		case <-ticker.C:
+			log.Printf("[DEBUG] Still waiting to lock %q after %s - %s", key, time.Since(started).Round(time.Second), m.debugDump())
+
+		case <-ctx.Done():
+			return fmt.Errorf("waiting to lock %q after %s: %+v - %s", key, time.Since(started).Round(time.Second), ctx.Err(), m.debugDump())
+		}
+	}
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+	entry := m.get(key)
+
+	m.lock.Lock()
+	entry.lockedAt = time.Time{}
+	m.lock.Unlock()
+
+	select {
+	case <-entry.ch:
+	default:
+		// matches the behaviour of sync.Mutex
+		panic(fmt.Sprintf("unlock of unlocked key %q", key))
+	}
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
+// debugDump returns a summary of the keys which are currently locked (and for how long)
+// along with the number of callers waiting on each - to help diagnose a stuck apply
+func (m *mutexKV) debugDump() string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	keys := make([]string, 0)
+	for key, entry := range m.store {
+		if !entry.lockedAt.IsZero() || entry.waiting > 0 {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return "no locks are currently held"
+	}
+	sort.Strings(keys)
+
+	now := time.Now()
+	items := make([]string, 0, len(keys))
+	for _, key := range keys {
+		entry := m.store[key]
+		status := "unlocked"
+		if !entry.lockedAt.IsZero() {
+			status = fmt.Sprintf("held for %s", now.Sub(entry.lockedAt).Round(time.Second))
+		}
+		items = append(items, fmt.Sprintf("%q (%s, %d waiting)", key, status, entry.waiting))
+	}
+
+	return fmt.Sprintf("currently held locks: %s", strings.Join(items, ", "))
+}
+
+// waitFor returns the mutex for the given key, recording that a caller is waiting on it
+func (m *mutexKV) waitFor(key string) *mutexEntry {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	entry := m.getLocked(key)
+	entry.waiting++
+	return entry
+}
+
+func (m *mutexKV) doneWaitingFor(entry *mutexEntry) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	entry.waiting--
+}
+
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) get(key string) *mutexEntry {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+	return m.getLocked(key)
+}
+
+// getLocked returns the mutex for the given key - the caller must hold m.lock
+func (m *mutexKV) getLocked(key string) *mutexEntry {
+	entry, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		entry = &mutexEntry{
+			ch: make(chan struct{}, 1),
+		}
+		m.store[key] = entry
 	}
-	return mutex
+	return entry
 }
 
 // Returns a properly initialized mutexKV
 func NewMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store:           make(map[string]*mutexEntry),
+		waitLogInterval: defaultWaitLogInterval,
 	}
 }
@@ -0,0 +1,120 @@
+package locks
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMutexKVLockUnlock(t *testing.T) {
+	m := NewMutexKV()
+	m.Lock("first")
+	m.Lock("second")
+
+	if dump := m.debugDump(); !strings.Contains(dump, `"first"`) || !strings.Contains(dump, `"second"`) {
+		t.Fatalf("expected both keys to be listed as held but got %q", dump)
+	}
+
+	m.Unlock("first")
+	m.Unlock("second")
+
+	if dump := m.debugDump(); dump != "no locks are currently held" {
+		t.Fatalf("expected no keys to be listed as held but got %q", dump)
+	}
+}
+
+func TestMutexKVLockWithContextTimeout(t *testing.T) {
+	m := NewMutexKV()
+	m.Lock("example")
+	defer m.Unlock("example")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := m.LockWithContext(ctx, "example")
+	if err == nil {
+		t.Fatalf("expected an error when the lock is already held but didn't get one")
+	}
+	if !strings.Contains(err.Error(), `waiting to lock "example"`) {
+		t.Fatalf("expected the error to contain the key being waited on but got %q", err.Error())
+	}
+	if !strings.Contains(err.Error(), `"example" (held for`) {
+		t.Fatalf("expected the error to contain the held locks but got %q", err.Error())
+	}
+
+	// the timed out caller should no longer be counted as waiting
+	if dump := m.debugDump(); !strings.Contains(dump, "0 waiting") {
+		t.Fatalf("expected no callers to be waiting but got %q", dump)
+	}
+}
+
+func TestMutexKVLockWithContextWaits(t *testing.T) {
+	m := NewMutexKV()
+	m.waitLogInterval = 10 * time.Millisecond
+	m.Lock("example")
+
+	locked := make(chan error)
+	go func() {
+		locked <- m.LockWithContext(context.Background(), "example")
+	}()
+
+	select {
+	case <-locked:
+		t.Fatalf("expected the lock to be unavailable whilst it's held")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if dump := m.debugDump(); !strings.Contains(dump, "1 waiting") {
+		t.Fatalf("expected a caller to be waiting but got %q", dump)
+	}
+
+	m.Unlock("example")
+	select {
+	case err := <-locked:
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected the lock to be acquired once released")
+	}
+	m.Unlock("example")
+}
+
+func TestMutexKVUnlockUnlocked(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("expected unlocking an unlocked key to panic")
+		}
+	}()
+
+	m := NewMutexKV()
+	m.Unlock("example")
+}
+
+func TestMultipleByNameWithContextReleasesOnError(t *testing.T) {
+	resourceType := "azurerm_locks_test"
+	ByName("b", resourceType)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	names := []string{"c", "b", "a"}
+	if err := MultipleByNameWithContext(ctx, &names, resourceType); err == nil {
+		t.Fatalf("expected an error since %q is already locked", "b")
+	}
+
+	// "a" is acquired before "b" since these are sorted, so should have been released
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel2()
+	if err := ByNameWithContext(ctx2, "a", resourceType); err != nil {
+		t.Fatalf("expected %q to have been released but got: %+v", "a", err)
+	}
+	UnlockByName("a", resourceType)
+	UnlockByName("b", resourceType)
+
+	if err := MultipleByNameWithContext(context.Background(), &names, resourceType); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	UnlockMultipleByName(&names, resourceType)
+}
@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+// supportsDefaultTags returns whether the Resource uses the Tags Schema (e.g. `tags.Schema()`), and as
+// such whether the Default Tags specified in the Provider block should be assigned to this Resource
+func supportsDefaultTags(resource *schema.Resource) bool {
+	v, ok := resource.Schema["tags"]
+	if !ok || v.Type != schema.TypeMap || !v.Optional || v.Computed {
+		return false
+	}
+
+	elem, ok := v.Elem.(*schema.Schema)
+	return ok && elem.Type == schema.TypeString
+}
+
+// withDefaultTags adds the Computed field `tags_all` to the Resource, containing all of the Tags assigned
+// to the Resource (being the Default Tags specified in the Provider block merged with the Tags specified
+// on the Resource) - which is calculated during the plan, so that changes to the Default Tags (and to any
+// Tags changed outside of Terraform) are shown in the diff.
+//
+// The Create, Read and Update functions for the Resource are then wrapped so that all of these Tags are
+// sent to Azure, whilst `tags` in the State only contains the Tags specified on the Resource.
+func withDefaultTags(resource *schema.Resource) {
+	if !supportsDefaultTags(resource) {
+		return
+	}
+
+	resource.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	// Resources which can't update their Tags in-place are only assigned the Default Tags when they're
+	// created (or replaced), rather than being replaced when only the Default Tags change
+	supportsTagsUpdate := (resource.Update != nil || resource.UpdateContext != nil) && !resource.Schema["tags"].ForceNew
+	customizeDiff := customizeDiffForDefaultTags(supportsTagsUpdate)
+	if resource.CustomizeDiff != nil {
+		resource.CustomizeDiff = customdiff.Sequence(resource.CustomizeDiff, customizeDiff)
+	} else {
+		resource.CustomizeDiff = customizeDiff
+	}
+
+	if resource.Create != nil {
+		resource.Create = schema.CreateFunc(applyWithDefaultTags(resource.Create))
+	}
+	if resource.CreateContext != nil {
+		resource.CreateContext = schema.CreateContextFunc(applyContextWithDefaultTags(resource.CreateContext))
+	}
+	if resource.Update != nil {
+		resource.Update = schema.UpdateFunc(applyWithDefaultTags(schema.CreateFunc(resource.Update)))
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = schema.UpdateContextFunc(applyContextWithDefaultTags(schema.CreateContextFunc(resource.UpdateContext)))
+	}
+	if resource.Read != nil {
+		resource.Read = readWithDefaultTags(resource.Read)
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = readContextWithDefaultTags(resource.ReadContext)
+	}
+}
+
+// customizeDiffForDefaultTags calculates the Tags which should be assigned to this Resource, such that
+// a change to either the Default Tags or the Tags assigned in Azure results in a diff for `tags_all`
+func customizeDiffForDefaultTags(supportsTagsUpdate bool) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !supportsTagsUpdate && d.Id() != "" && !d.HasChange("tags") {
+			return nil
+		}
+
+		if !d.NewValueKnown("tags") {
+			return d.SetNewComputed("tags_all")
+		}
+
+		var defaultTags map[string]interface{}
+		if client, ok := meta.(*clients.Client); ok {
+			defaultTags = client.DefaultTags
+		}
+
+		return d.SetNew("tags_all", tags.MergeDefaults(defaultTags, d.Get("tags").(map[string]interface{})))
+	}
+}
+
+func applyWithDefaultTags(apply schema.CreateFunc) schema.CreateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*clients.Client)
+
+		configured := d.Get("tags").(map[string]interface{})
+		expected := tags.MergeDefaults(client.DefaultTags, configured)
+		if err := d.Set("tags", expected); err != nil {
+			return fmt.Errorf("setting `tags`: %+v", err)
+		}
+
+		// the State must only contain the Tags specified on the Resource, even when this fails
+		applyErr := apply(d, meta)
+		if applyErr == nil {
+			ctx, cancel := timeouts.ForUpdate(client.StopContext, d)
+			defer cancel()
+
+			applyErr = ensureTagsAreAssigned(ctx, d, client, expected)
+		}
+
+		if err := setTagsWithoutDefaults(d, client.DefaultTags, configured); err != nil {
+			if applyErr != nil {
+				return applyErr
+			}
+			return err
+		}
+
+		return applyErr
+	}
+}
+
+func applyContextWithDefaultTags(apply schema.CreateContextFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*clients.Client)
+
+		configured := d.Get("tags").(map[string]interface{})
+		expected := tags.MergeDefaults(client.DefaultTags, configured)
+		if err := d.Set("tags", expected); err != nil {
+			return diag.Errorf("setting `tags`: %+v", err)
+		}
+
+		diags := apply(ctx, d, meta)
+		if !diags.HasError() {
+			if err := ensureTagsAreAssigned(ctx, d, client, expected); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+
+		if err := setTagsWithoutDefaults(d, client.DefaultTags, configured); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+func readWithDefaultTags(read schema.ReadFunc) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		keepAll, previous := tagsToKeepWhenReading(d)
+		defaultTags := defaultTagsWhenReading(d, meta.(*clients.Client).DefaultTags)
+
+		if err := read(d, meta); err != nil {
+			return err
+		}
+
+		if keepAll {
+			previous = d.Get("tags").(map[string]interface{})
+		}
+		return setTagsWithoutDefaults(d, defaultTags, previous)
+	}
+}
+
+func readContextWithDefaultTags(read schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keepAll, previous := tagsToKeepWhenReading(d)
+		defaultTags := defaultTagsWhenReading(d, meta.(*clients.Client).DefaultTags)
+
+		diags := read(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+
+		if keepAll {
+			previous = d.Get("tags").(map[string]interface{})
+		}
+		if err := setTagsWithoutDefaults(d, defaultTags, previous); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+// tagsToKeepWhenReading returns the Tags which should be retained in `tags` when reading the Resource, since
+// the State only contains the Tags specified on the Resource, any Default Tags also specified on the Resource
+// must be retained. When importing the Resource (or where the State predates `tags_all`) it's not possible to
+// determine which of these are specified on the Resource, so all of the Tags assigned in Azure are retained -
+// with any Default Tags which aren't specified on the Resource being removed from `tags` when next applied.
+func tagsToKeepWhenReading(d *schema.ResourceData) (keepAll bool, previous map[string]interface{}) {
+	if len(d.Get("tags_all").(map[string]interface{})) == 0 {
+		return true, nil
+	}
+
+	return false, d.Get("tags").(map[string]interface{})
+}
+
+// defaultTagsWhenReading returns the Default Tags which should be removed from `tags` when reading the Resource,
+// being the Default Tags specified in the Provider block and any Default Tags previously assigned to the Resource
+// (that is, those in `tags_all` but not `tags`) - such that removing a Default Tag from the Provider block only
+// changes `tags_all`, rather than `tags` (which would replace Resources which can't update their Tags in-place)
+func defaultTagsWhenReading(d *schema.ResourceData, defaultTags map[string]interface{}) map[string]interface{} {
+	configured := d.Get("tags").(map[string]interface{})
+	output := make(map[string]interface{})
+	for k, v := range d.Get("tags_all").(map[string]interface{}) {
+		if _, ok := configured[k]; !ok {
+			output[k] = v
+		}
+	}
+	for k, v := range defaultTags {
+		output[k] = v
+	}
+
+	return output
+}
+
+// ensureTagsAreAssigned confirms that the expected Tags have been assigned to the Resource - since the Update
+// function for some Resources only sends the Tags when `tags` has changed, meaning that a change to only the
+// Default Tags (or to the Tags in Azure) wouldn't otherwise be applied. Where these differ the Tags API is used
+// to add/update and remove the individual Tags, such that any other Tags (e.g. hidden Tags) are left as-is.
+func ensureTagsAreAssigned(ctx context.Context, d *schema.ResourceData, client *clients.Client, expected map[string]interface{}) error {
+	// the Resource has been removed
+	if d.Id() == "" {
+		return nil
+	}
+
+	// the Tags API can only be used for Resources within Azure Resource Manager, rather than (for example)
+	// Key Vault Keys, whose ID is a Data Plane URI
+	if _, err := azure.ParseAzureResourceID(d.Id()); err != nil {
+		return nil
+	}
+
+	// the Tags are assigned by the Resource as usual when no Default Tags are specified, unless Default Tags
+	// were previously assigned to this Resource - in which case these need to be removed
+	if len(client.DefaultTags) == 0 && !hasTagsWhichAreNotConfigured(d, expected) {
+		return nil
+	}
+
+	// the Update function will have refreshed the Tags from Azure, where these are retrieved
+	existing := d.Get("tags").(map[string]interface{})
+	if reflect.DeepEqual(existing, expected) {
+		return nil
+	}
+
+	changed := make(map[string]interface{})
+	for k, v := range expected {
+		if existingValue, ok := existing[k]; !ok || existingValue != v {
+			changed[k] = v
+		}
+	}
+	removed := make(map[string]interface{})
+	for k, v := range existing {
+		if _, ok := expected[k]; !ok {
+			removed[k] = v
+		}
+	}
+
+	tagsClient := client.Resource.TagsClient
+	if len(changed) > 0 {
+		patch := resources.TagsPatchResource{
+			Operation: resources.TagsPatchOperationMerge,
+			Properties: &resources.Tags{
+				Tags: tags.Expand(changed),
+			},
+		}
+		if _, err := tagsClient.UpdateAtScope(ctx, d.Id(), patch); err != nil {
+			return fmt.Errorf("assigning Tags to %q: %+v", d.Id(), err)
+		}
+	}
+	if len(removed) > 0 {
+		patch := resources.TagsPatchResource{
+			Operation: resources.TagsPatchOperationDelete,
+			Properties: &resources.Tags{
+				Tags: tags.Expand(removed),
+			},
+		}
+		if _, err := tagsClient.UpdateAtScope(ctx, d.Id(), patch); err != nil {
+			return fmt.Errorf("removing Tags from %q: %+v", d.Id(), err)
+		}
+	}
+
+	if err := d.Set("tags", expected); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+// hasTagsWhichAreNotConfigured returns whether the Tags previously assigned to the Resource (including any
+// Default Tags) contain any Tags which aren't configured on the Resource
+func hasTagsWhichAreNotConfigured(d *schema.ResourceData, configured map[string]interface{}) bool {
+	previous, _ := d.GetChange("tags_all")
+	for k := range previous.(map[string]interface{}) {
+		if _, ok := configured[k]; !ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setTagsWithoutDefaults sets `tags_all` to all of the Tags assigned to the Resource, and `tags` to these
+// Tags without the Default Tags - such that `tags` only contains the Tags specified on the Resource (and
+// any within `keep`, for example Default Tags which are also specified on the Resource)
+func setTagsWithoutDefaults(d *schema.ResourceData, defaultTags map[string]interface{}, keep map[string]interface{}) error {
+	// the Resource has been removed
+	if d.Id() == "" {
+		return nil
+	}
+
+	existing := d.Get("tags").(map[string]interface{})
+
+	if err := d.Set("tags_all", existing); err != nil {
+		return fmt.Errorf("setting `tags_all`: %+v", err)
+	}
+
+	if err := d.Set("tags", tags.RemoveDefaults(existing, defaultTags, keep)); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/fakearm"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
+)
+
+func TestDefaultTags_againstFakeServer(t *testing.T) {
+	server := fakearm.NewServer(t)
+	lifecycle := server.Lifecycle(t, "azurerm_resource_group")
+	lifecycle.Client().DefaultTags = map[string]interface{}{
+		"owner":       "platform-team",
+		"environment": "Development",
+	}
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-defaulttags", fakearm.SubscriptionId)
+
+	// Apply confirms that there's no diff once the Resource has been refreshed
+	state := lifecycle.Apply(map[string]interface{}{
+		"name":     "acctestRG-defaulttags",
+		"location": "West Europe",
+		"tags": map[string]interface{}{
+			"environment": "Production",
+		},
+	})
+	if v := state.Attributes["tags.%"]; v != "1" {
+		t.Fatalf("expected only the Tags specified on the Resource to be in `tags` but got %q Tags", v)
+	}
+	if v := state.Attributes["tags_all.%"]; v != "2" {
+		t.Fatalf("expected all of the Tags to be in `tags_all` but got %q Tags", v)
+	}
+
+	resource, exists := server.Resource(id)
+	if !exists {
+		t.Fatalf("expected the Resource Group %q to exist", id)
+	}
+	expected := map[string]interface{}{
+		"owner":       "platform-team",
+		"environment": "Production",
+	}
+	if actual := resource["tags"]; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected the Tags in Azure to be %+v but got %+v", expected, actual)
+	}
+
+	// a Default Tag which is also specified on the Resource should remain in the State
+	config := map[string]interface{}{
+		"name":     "acctestRG-defaulttags",
+		"location": "West Europe",
+		"tags": map[string]interface{}{
+			"owner": "platform-team",
+		},
+	}
+	state = lifecycle.Apply(config)
+	if v := state.Attributes["tags.owner"]; v != "platform-team" {
+		t.Fatalf("expected the tag `owner` to be %q but got %q", "platform-team", v)
+	}
+
+	resource, _ = server.Resource(id)
+	expected = map[string]interface{}{
+		"owner":       "platform-team",
+		"environment": "Development",
+	}
+	if actual := resource["tags"]; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected the Tags in Azure to be %+v but got %+v", expected, actual)
+	}
+
+	// changing only the Default Tags should update the Resource
+	lifecycle.Client().DefaultTags = map[string]interface{}{
+		"cost-center": "1234",
+	}
+	state = lifecycle.Apply(config)
+	if v := state.Attributes["tags.%"]; v != "1" {
+		t.Fatalf("expected only the Tags specified on the Resource to be in `tags` but got %q Tags", v)
+	}
+
+	resource, _ = server.Resource(id)
+	expected = map[string]interface{}{
+		"owner":       "platform-team",
+		"cost-center": "1234",
+	}
+	if actual := resource["tags"]; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected the Tags in Azure to be %+v but got %+v", expected, actual)
+	}
+
+	// removing a Default Tag outside of Terraform should show a diff, which is then reverted
+	delete(resource["tags"].(map[string]interface{}), "cost-center")
+	state = lifecycle.Refresh()
+	if v := state.Attributes["tags_all.%"]; v != "1" {
+		t.Fatalf("expected the removed Default Tag to be removed from `tags_all` but got %q Tags", v)
+	}
+	lifecycle.Apply(config)
+
+	resource, _ = server.Resource(id)
+	if actual := resource["tags"]; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected the Tags in Azure to be %+v but got %+v", expected, actual)
+	}
+
+	lifecycle.Destroy()
+}
+
+func TestDefaultTags_onlyUpdatingChangedTagsAgainstFakeServer(t *testing.T) {
+	server := fakearm.NewServer(t)
+
+	resourceGroup := server.Lifecycle(t, "azurerm_resource_group")
+	resourceGroup.Apply(map[string]interface{}{
+		"name":     "acctestRG-defaulttags",
+		"location": "West Europe",
+	})
+
+	// the Update function for the SSH Public Key only sends the Tags when `tags` has changed
+	lifecycle := server.Lifecycle(t, "azurerm_ssh_public_key")
+	lifecycle.Client().DefaultTags = map[string]interface{}{
+		"owner": "platform-team",
+	}
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-defaulttags/providers/Microsoft.Compute/sshPublicKeys/acctestsshkey", fakearm.SubscriptionId)
+	config := map[string]interface{}{
+		"name":                "acctestsshkey",
+		"resource_group_name": "acctestRG-defaulttags",
+		"location":            "West Europe",
+		"public_key":          "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC+wWK73dCr+jgQOAxNsHAnNNNMEMWOHYEccp6wJm2gotpr9katuF/ZAdou5AaW1C61slRkHRkpRRX9FA9CYBiitZgvCCz+3nWNN7l/Up54Zps/pHWGZLHNJZRYyAB6j5yVLMVHIHriY49d/GZTZVNB8GoJv9Gakwc/fuEZYYl4YDFiGMBP///TzlI4jhiJzjKnEvqPFki5p2ZRJqcbCiF4pJrxUQR/RXqVFQdbRLZgYfJ8xGB878RENq3yQ39d8dVOkq4edbkzwcUmwwwkYVPIoDGsYLaRHnG+To7FvMeyO7xDVQkMKzopTQV8AuKpyvpqu0a9pWOMaiCyDytO7GGN you@me.com",
+		"tags": map[string]interface{}{
+			"environment": "Production",
+		},
+	}
+	lifecycle.Apply(config)
+
+	lifecycle.Client().DefaultTags = map[string]interface{}{
+		"cost-center": "1234",
+	}
+	lifecycle.Apply(config)
+
+	resource, _ := server.Resource(id)
+	expected := map[string]interface{}{
+		"environment": "Production",
+		"cost-center": "1234",
+	}
+	if actual := resource["tags"]; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected the Tags in Azure to be %+v but got %+v", expected, actual)
+	}
+
+	// one request to add the new Default Tag, and one to remove the old Default Tag
+	if tagsRequests := countTagsRequests(server); tagsRequests != 2 {
+		t.Fatalf("expected 2 requests to the Tags API but got %d", tagsRequests)
+	}
+
+	lifecycle.Destroy()
+}
+
+func TestDefaultTags_notUsingTagsAPIWithoutDefaultTagsAgainstFakeServer(t *testing.T) {
+	server := fakearm.NewServer(t)
+	lifecycle := server.Lifecycle(t, "azurerm_resource_group")
+	config := map[string]interface{}{
+		"name":     "acctestRG-defaulttags",
+		"location": "West Europe",
+		"tags": map[string]interface{}{
+			"environment": "Production",
+		},
+	}
+	lifecycle.Apply(config)
+
+	config["tags"] = map[string]interface{}{
+		"environment": "Development",
+	}
+	lifecycle.Apply(config)
+
+	if tagsRequests := countTagsRequests(server); tagsRequests != 0 {
+		t.Fatalf("expected no requests to the Tags API but got %d", tagsRequests)
+	}
+
+	lifecycle.Destroy()
+}
+
+func TestDefaultTags_importKeepsConfiguredDefaultTagsAgainstFakeServer(t *testing.T) {
+	server := fakearm.NewServer(t)
+	lifecycle := server.Lifecycle(t, "azurerm_resource_group")
+	lifecycle.Client().DefaultTags = map[string]interface{}{
+		"owner":       "platform-team",
+		"environment": "Development",
+	}
+	config := map[string]interface{}{
+		"name":     "acctestRG-defaulttags",
+		"location": "West Europe",
+		"tags": map[string]interface{}{
+			"owner": "platform-team",
+		},
+	}
+	lifecycle.Apply(config)
+
+	// importing the Resource only populates the ID prior to it being read
+	ctx := context.TODO()
+	client, err := server.Client(ctx)
+	if err != nil {
+		t.Fatalf("building Client: %+v", err)
+	}
+	client.DefaultTags = lifecycle.Client().DefaultTags
+	resource := provider.TestAzureProvider().ResourcesMap["azurerm_resource_group"]
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-defaulttags", fakearm.SubscriptionId)
+	state, diags := resource.RefreshWithoutUpgrade(ctx, &terraform.InstanceState{ID: id}, client)
+	if diags.HasError() {
+		t.Fatalf("importing: %+v", diags)
+	}
+
+	if v := state.Attributes["tags.owner"]; v != "platform-team" {
+		t.Fatalf("expected the tag `owner` to be %q after import but got %q", "platform-team", v)
+	}
+
+	diff, err := resource.Diff(ctx, state, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("planning changes: %+v", err)
+	}
+	if diff != nil {
+		if _, ok := diff.Attributes["tags.owner"]; ok {
+			t.Fatalf("expected no changes to the tag `owner` after import but got: %+v", diff.Attributes["tags.owner"])
+		}
+	}
+
+	lifecycle.Destroy()
+}
+
+func TestDefaultTags_changingDefaultTagsDoesNotReplaceResourcesWithoutUpdateAgainstFakeServer(t *testing.T) {
+	server := fakearm.NewServer(t)
+
+	resourceGroup := server.Lifecycle(t, "azurerm_resource_group")
+	resourceGroup.Apply(map[string]interface{}{
+		"name":     "acctestRG-defaulttags",
+		"location": "West Europe",
+	})
+
+	// the Spatial Anchors Account has no Update function, and as such `tags` is ForceNew
+	lifecycle := server.Lifecycle(t, "azurerm_spatial_anchors_account")
+	lifecycle.Client().DefaultTags = map[string]interface{}{
+		"owner": "platform-team",
+	}
+	config := map[string]interface{}{
+		"name":                "acctestspatialanchors",
+		"resource_group_name": "acctestRG-defaulttags",
+		"location":            "West Europe",
+		"tags": map[string]interface{}{
+			"environment": "Production",
+		},
+	}
+	state := lifecycle.Apply(config)
+	if v := state.Attributes["tags_all.owner"]; v != "platform-team" {
+		t.Fatalf("expected the Default Tag `owner` to be assigned when created but got %q", v)
+	}
+
+	// Apply confirms that changing only the Default Tags results in no diff
+	lifecycle.Client().DefaultTags = map[string]interface{}{
+		"cost-center": "1234",
+	}
+	lifecycle.Apply(config)
+
+	lifecycle.Destroy()
+}
+
+func countTagsRequests(server *fakearm.Server) int {
+	tagsRequests := 0
+	for _, request := range server.Requests() {
+		if strings.HasSuffix(request, "/providers/Microsoft.Resources/tags/default") {
+			tagsRequests++
+		}
+	}
+	return tagsRequests
+}
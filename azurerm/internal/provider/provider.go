@@ -6,15 +6,18 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,6 +29,14 @@ func TestAzureProvider() *schema.Provider {
 	return azureProvider(true)
 }
 
+// TestAzureProviderWithRecorder returns the Provider used in the Acceptance Tests, where the
+// requests sent to Azure are recorded (or replayed) by the specified RequestRecorder
+func TestAzureProviderWithRecorder(recorder common.RequestRecorder) *schema.Provider {
+	p := azureProvider(true)
+	p.ConfigureContextFunc = providerConfigure(p, recorder)
+	return p
+}
+
 func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 	// avoids this showing up in test output
 	debugLog := func(f string, v ...interface{}) {
@@ -98,6 +109,11 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
+	// the Default Tags specified in the Provider block are assigned to each Resource which supports Tags
+	for _, resource := range resources {
+		withDefaultTags(resource)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -212,6 +228,79 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "This will disable the Terraform Partner ID which is used if a custom `partner_id` isn't specified.",
 			},
 
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", common.DefaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of times a request to Azure which fails with a transient error should be retried.",
+			},
+
+			"max_throttled_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_THROTTLED_RETRIES", common.DefaultMaxThrottledRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of times a request to Azure which is throttled should be retried.",
+			},
+
+			"retry_backoff_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RETRY_BACKOFF_SECONDS", int(common.DefaultRetryBackoff.Seconds())),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The initial number of seconds to wait before retrying a request to Azure, which is doubled for each subsequent retry.",
+			},
+
+			"retry_max_backoff_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RETRY_MAX_BACKOFF_SECONDS", int(common.DefaultRetryMaxBackoff.Seconds())),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of seconds to wait before retrying a request to Azure, unless Azure returns a `Retry-After` header.",
+			},
+
+			"disable_retry_after_header": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_DISABLE_RETRY_AFTER_HEADER", false),
+				Description: "This will ignore the `Retry-After` header returned from Azure when retrying requests, using an exponential backoff instead.",
+			},
+
+			"read_requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_READ_REQUESTS_PER_SECOND", 0.0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The sustained number of read requests per second which can be made to Azure Resource Manager for this Subscription. Defaults to `0` (unlimited).",
+			},
+
+			"read_requests_burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_READ_REQUESTS_BURST", 1),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of read requests which can be made to Azure Resource Manager at once, before being limited to `read_requests_per_second`.",
+			},
+
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of requests which can be in-flight to Azure Resource Manager at once for this Subscription. Defaults to `0` (unlimited).",
+			},
+
+			"default_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: tags.Validate,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "A mapping of Tags which should be assigned to all Resources supporting Tags, in addition to the Tags specified on each Resource.",
+			},
+
 			"features": schemaFeatures(supportLegacyTestSuite),
 
 			// Advanced feature flags
@@ -243,12 +332,12 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
-	p.ConfigureContextFunc = providerConfigure(p)
+	p.ConfigureContextFunc = providerConfigure(p, nil)
 
 	return p
 }
 
-func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
+func providerConfigure(p *schema.Provider, recorder common.RequestRecorder) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		var auxTenants []string
 		if v, ok := d.Get("auxiliary_tenant_ids").([]interface{}); ok && len(v) > 0 {
@@ -311,10 +400,14 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			SkipProviderRegistration:    skipProviderRegistration,
 			TerraformVersion:            terraformVersion,
 			PartnerId:                   d.Get("partner_id").(string),
+			DefaultTags:                 d.Get("default_tags").(map[string]interface{}),
 			DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 			DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 			Features:                    expandFeatures(d.Get("features").([]interface{})),
 			StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
+			RetryPolicy:                 expandRetryPolicy(d),
+			RateLimit:                   expandRateLimit(d),
+			Recorder:                    recorder,
 
 			// this field is intentionally not exposed in the provider block, since it's only used for
 			// platform level tracing
@@ -355,6 +448,24 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 	}
 }
 
+func expandRetryPolicy(d *schema.ResourceData) *common.RetryPolicy {
+	return &common.RetryPolicy{
+		MaxRetries:          d.Get("max_retries").(int),
+		MaxThrottledRetries: d.Get("max_throttled_retries").(int),
+		Backoff:             time.Duration(d.Get("retry_backoff_seconds").(int)) * time.Second,
+		MaxBackoff:          time.Duration(d.Get("retry_max_backoff_seconds").(int)) * time.Second,
+		DisableRetryAfter:   d.Get("disable_retry_after_header").(bool),
+	}
+}
+
+func expandRateLimit(d *schema.ResourceData) *common.RateLimit {
+	return &common.RateLimit{
+		ReadsPerSecond:        d.Get("read_requests_per_second").(float64),
+		ReadBurst:             d.Get("read_requests_burst").(int),
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+	}
+}
+
 const resourceProviderRegistrationErrorFmt = `Error ensuring Resource Providers are registered.
 
 Terraform automatically attempts to register the Resource Providers it supports to
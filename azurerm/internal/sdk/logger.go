@@ -1,7 +1,22 @@
 package sdk
 
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
 // Logger is an interface for switching out the Logger implementation
 type Logger interface {
+	// Debug prints out a message prefixed with `[DEBUG]` verbatim
+	Debug(message string)
+
+	// Debugf prints out a message prefixed with `[DEBUG]` formatted
+	// with the specified arguments
+	Debugf(format string, args ...interface{})
+
 	// Info prints out a message prefixed with `[INFO]` verbatim
 	Info(message string)
 
@@ -15,4 +30,89 @@ type Logger interface {
 	// Warnf prints out a message prefixed with `[WARN]` formatted
 	// with the specified arguments
 	Warnf(format string, args ...interface{})
+
+	// Error prints out a message prefixed with `[ERROR]` verbatim
+	Error(message string)
+
+	// Errorf prints out a message prefixed with `[ERROR]` formatted
+	// with the specified arguments
+	Errorf(format string, args ...interface{})
+
+	// WithFields returns a copy of this Logger which includes the specified
+	// fields in each message, in addition to any existing fields
+	WithFields(fields LogFields) Logger
+}
+
+// LogFields are key/value pairs which are included in each log message
+//
+// Values implementing fmt.Stringer are evaluated when the message is written
+// - allowing for values which change over time (e.g. the Resource ID)
+type LogFields map[string]interface{}
+
+const (
+	// LogFieldCorrelationRequestID is the Correlation Request ID sent to Azure for each request
+	LogFieldCorrelationRequestID = "correlation_request_id"
+
+	// LogFieldResourceID is the ID of the Resource being operated on
+	LogFieldResourceID = "resource_id"
+
+	// LogFieldResourceType is the type of the Resource being operated on (e.g. `azurerm_example`)
+	LogFieldResourceType = "resource_type"
+)
+
+// merge returns a new LogFields containing the existing fields, overridden by the specified fields
+func (f LogFields) merge(fields LogFields) LogFields {
+	out := make(LogFields, len(f)+len(fields))
+	for k, v := range f {
+		out[k] = v
+	}
+	for k, v := range fields {
+		out[k] = v
+	}
+	return out
+}
+
+// formatLogMessage formats the message and any fields for the specified level (e.g. `INFO`)
+//
+// When Terraform's logging is enabled (via `TF_LOG`) the message and fields are output as
+// JSON to make these easier to parse - otherwise the fields are appended as `key=value`.
+// In both cases the message is prefixed with the level so that Terraform can filter these.
+func formatLogMessage(level, message string, fields LogFields) string {
+	if len(fields) == 0 {
+		return fmt.Sprintf("[%s] %s", level, message)
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	if os.Getenv("TF_LOG") != "" {
+		out := map[string]interface{}{
+			"@level":   strings.ToLower(level),
+			"@message": message,
+		}
+		for _, k := range keys {
+			out[k] = logFieldValue(fields[k])
+		}
+
+		if v, err := json.Marshal(out); err == nil {
+			return fmt.Sprintf("[%s] %s", level, string(v))
+		}
+	}
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", k, fmt.Sprint(logFieldValue(fields[k]))))
+	}
+	return fmt.Sprintf("[%s] %s (%s)", level, message, strings.Join(pairs, " "))
+}
+
+func logFieldValue(input interface{}) interface{} {
+	if v, ok := input.(fmt.Stringer); ok {
+		return v.String()
+	}
+
+	return input
 }
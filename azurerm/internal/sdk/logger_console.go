@@ -9,11 +9,24 @@ var _ Logger = ConsoleLogger{}
 
 // ConsoleLogger provides a Logger implementation which writes the log messages
 // to StdOut - in Terraform's perspective that's proxied via the Plugin SDK
-type ConsoleLogger struct{}
+type ConsoleLogger struct {
+	fields LogFields
+}
+
+// Debug prints out a message prefixed with `[DEBUG]` verbatim
+func (l ConsoleLogger) Debug(message string) {
+	log.Print(formatLogMessage("DEBUG", message, l.fields))
+}
+
+// Debugf prints out a message prefixed with `[DEBUG]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(fmt.Sprintf(format, args...))
+}
 
 // Info prints out a message prefixed with `[INFO]` verbatim
-func (ConsoleLogger) Info(message string) {
-	log.Print(fmt.Sprintf("[INFO] %s", message))
+func (l ConsoleLogger) Info(message string) {
+	log.Print(formatLogMessage("INFO", message, l.fields))
 }
 
 // Infof prints out a message prefixed with `[INFO]` formatted
@@ -24,7 +37,7 @@ func (l ConsoleLogger) Infof(format string, args ...interface{}) {
 
 // Warn prints out a message prefixed with `[WARN]` formatted verbatim
 func (l ConsoleLogger) Warn(message string) {
-	log.Print(fmt.Sprintf("[WARN] %s", message))
+	log.Print(formatLogMessage("WARN", message, l.fields))
 }
 
 // Warnf prints out a message prefixed with `[WARN]` formatted
@@ -32,3 +45,22 @@ func (l ConsoleLogger) Warn(message string) {
 func (l ConsoleLogger) Warnf(format string, args ...interface{}) {
 	l.Warn(fmt.Sprintf(format, args...))
 }
+
+// Error prints out a message prefixed with `[ERROR]` verbatim
+func (l ConsoleLogger) Error(message string) {
+	log.Print(formatLogMessage("ERROR", message, l.fields))
+}
+
+// Errorf prints out a message prefixed with `[ERROR]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Errorf(format string, args ...interface{}) {
+	l.Error(fmt.Sprintf(format, args...))
+}
+
+// WithFields returns a copy of this Logger which includes the specified
+// fields in each message, in addition to any existing fields
+func (l ConsoleLogger) WithFields(fields LogFields) Logger {
+	return ConsoleLogger{
+		fields: l.fields.merge(fields),
+	}
+}
@@ -11,18 +11,32 @@ var _ Logger = &DiagnosticsLogger{}
 
 type DiagnosticsLogger struct {
 	diagnostics diag.Diagnostics
+	fields      LogFields
+
+	// parent is the DiagnosticsLogger which this was created from (via WithFields)
+	// which any Diagnostics are added to, so that these can be returned to Terraform
+	parent *DiagnosticsLogger
+}
+
+func (d *DiagnosticsLogger) Debug(message string) {
+	log.Print(formatLogMessage("DEBUG", message, d.fields))
+}
+
+func (d *DiagnosticsLogger) Debugf(format string, args ...interface{}) {
+	d.Debug(fmt.Sprintf(format, args...))
 }
 
 func (d *DiagnosticsLogger) Info(message string) {
-	log.Printf("[INFO] %s", message)
+	log.Print(formatLogMessage("INFO", message, d.fields))
 }
 
 func (d *DiagnosticsLogger) Infof(format string, args ...interface{}) {
-	log.Printf("[INFO] "+format, args...)
+	d.Info(fmt.Sprintf(format, args...))
 }
 
 func (d *DiagnosticsLogger) Warn(message string) {
-	d.diagnostics = append(d.diagnostics, diag.Diagnostic{
+	log.Print(formatLogMessage("WARN", message, d.fields))
+	d.appendDiagnostic(diag.Diagnostic{
 		Severity:      diag.Warning,
 		Summary:       message,
 		Detail:        message,
@@ -31,10 +45,35 @@ func (d *DiagnosticsLogger) Warn(message string) {
 }
 
 func (d *DiagnosticsLogger) Warnf(format string, args ...interface{}) {
-	d.diagnostics = append(d.diagnostics, diag.Diagnostic{
-		Severity:      diag.Warning,
-		Summary:       fmt.Sprintf(format, args...),
-		Detail:        fmt.Sprintf(format, args...),
-		AttributePath: nil,
-	})
+	d.Warn(fmt.Sprintf(format, args...))
+}
+
+// Error only logs the message, since returning an Error Diagnostic would fail the operation
+// - instead the Resource should return an error
+func (d *DiagnosticsLogger) Error(message string) {
+	log.Print(formatLogMessage("ERROR", message, d.fields))
+}
+
+func (d *DiagnosticsLogger) Errorf(format string, args ...interface{}) {
+	d.Error(fmt.Sprintf(format, args...))
+}
+
+func (d *DiagnosticsLogger) WithFields(fields LogFields) Logger {
+	return &DiagnosticsLogger{
+		fields: d.fields.merge(fields),
+		parent: d.root(),
+	}
+}
+
+func (d *DiagnosticsLogger) appendDiagnostic(diagnostic diag.Diagnostic) {
+	root := d.root()
+	root.diagnostics = append(root.diagnostics, diagnostic)
+}
+
+func (d *DiagnosticsLogger) root() *DiagnosticsLogger {
+	if d.parent != nil {
+		return d.parent
+	}
+
+	return d
 }
@@ -0,0 +1,148 @@
+package sdk
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestFormatLogMessage(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Level    string
+		Message  string
+		Fields   LogFields
+		Expected string
+	}{
+		{
+			Name:     "No Fields",
+			Level:    "INFO",
+			Message:  "hello world",
+			Expected: "[INFO] hello world",
+		},
+		{
+			Name:    "Single Field",
+			Level:   "DEBUG",
+			Message: "hello world",
+			Fields: LogFields{
+				LogFieldResourceType: "azurerm_example",
+			},
+			Expected: `[DEBUG] hello world (resource_type="azurerm_example")`,
+		},
+		{
+			Name:    "Multiple Fields are Sorted",
+			Level:   "WARN",
+			Message: "hello world",
+			Fields: LogFields{
+				LogFieldResourceType: "azurerm_example",
+				LogFieldResourceID:   logFieldFunc(func() string { return "/some/id" }),
+				"count":              3,
+			},
+			Expected: `[WARN] hello world (count="3" resource_id="/some/id" resource_type="azurerm_example")`,
+		},
+	}
+
+	os.Unsetenv("TF_LOG")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := formatLogMessage(v.Level, v.Message, v.Fields)
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestFormatLogMessageJSON(t *testing.T) {
+	existing := os.Getenv("TF_LOG")
+	defer os.Setenv("TF_LOG", existing)
+	os.Setenv("TF_LOG", "DEBUG")
+
+	actual := formatLogMessage("ERROR", "hello world", LogFields{
+		LogFieldResourceType:         "azurerm_example",
+		LogFieldResourceID:           logFieldFunc(func() string { return "/some/id" }),
+		LogFieldCorrelationRequestID: "abc123",
+	})
+
+	if !strings.HasPrefix(actual, "[ERROR] ") {
+		t.Fatalf("expected the message to be prefixed with the level but got %q", actual)
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(actual, "[ERROR] ")), &out); err != nil {
+		t.Fatalf("unmarshaling %q: %+v", actual, err)
+	}
+
+	expected := map[string]string{
+		"@level":                     "error",
+		"@message":                   "hello world",
+		LogFieldResourceType:         "azurerm_example",
+		LogFieldResourceID:           "/some/id",
+		LogFieldCorrelationRequestID: "abc123",
+	}
+	if len(out) != len(expected) {
+		t.Fatalf("expected %d fields but got %d: %+v", len(expected), len(out), out)
+	}
+	for k, v := range expected {
+		if out[k] != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, out[k])
+		}
+	}
+}
+
+func TestLogFieldsMerge(t *testing.T) {
+	existing := LogFields{
+		"first":  "1",
+		"second": "2",
+	}
+	actual := existing.merge(LogFields{
+		"second": "two",
+		"third":  "3",
+	})
+
+	expected := LogFields{
+		"first":  "1",
+		"second": "two",
+		"third":  "3",
+	}
+	for k, v := range expected {
+		if actual[k] != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual[k])
+		}
+	}
+
+	if existing["second"] != "2" {
+		t.Fatalf("expected the existing fields to be unchanged but got %+v", existing)
+	}
+}
+
+func TestDiagnosticsLoggerWithFields(t *testing.T) {
+	logger := &DiagnosticsLogger{}
+	child := logger.WithFields(LogFields{
+		LogFieldResourceType: "azurerm_example",
+	})
+	grandChild := child.WithFields(LogFields{
+		LogFieldResourceID: "/some/id",
+	})
+
+	child.Warn("first")
+	grandChild.Warnf("second %d", 2)
+	grandChild.Info("not a diagnostic")
+	grandChild.Error("also not a diagnostic")
+
+	if len(logger.diagnostics) != 2 {
+		t.Fatalf("expected 2 diagnostics on the root logger but got %d", len(logger.diagnostics))
+	}
+	if logger.diagnostics[0].Summary != "first" {
+		t.Fatalf("expected the first diagnostic to be %q but got %q", "first", logger.diagnostics[0].Summary)
+	}
+	if logger.diagnostics[1].Summary != "second 2" {
+		t.Fatalf("expected the second diagnostic to be %q but got %q", "second 2", logger.diagnostics[1].Summary)
+	}
+
+	fields := grandChild.(*DiagnosticsLogger).fields
+	if len(fields) != 2 {
+		t.Fatalf("expected 2 fields but got %d", len(fields))
+	}
+}
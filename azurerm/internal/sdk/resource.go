@@ -63,10 +63,6 @@ type Resource interface {
 	IDValidationFunc() pluginsdk.SchemaValidateFunc
 }
 
-// TODO: ResourceWithCustomizeDiff
-// TODO: ResourceWithStateMigration
-// TODO: a generic state migration for updating ID's
-
 type ResourceWithCustomImporter interface {
 	Resource
 
@@ -88,6 +84,44 @@ type ResourceWithUpdate interface {
 	Update() ResourceFunc
 }
 
+// ResourceWithCustomizeDiff is an optional interface
+//
+// Resources implementing this interface can inspect (and modify) the
+// planned changes for this resource - for example to validate constraints
+// spanning multiple fields, or to mark a field as ForceNew based on its value.
+type ResourceWithCustomizeDiff interface {
+	Resource
+
+	// CustomizeDiff returns a ResourceFunc which is run during `terraform plan`,
+	// returning an error from this function will prevent the plan from completing.
+	// NOTE: the ResourceMetaData passed to this function has the ResourceDiff field
+	// populated - rather than the ResourceData field
+	CustomizeDiff() ResourceFunc
+}
+
+// ResourceWithStateMigration is an optional interface
+//
+// Resources implementing this interface are able to upgrade the data
+// stored in Terraform's State from a previous version of the Schema
+// to the current version of the Schema.
+type ResourceWithStateMigration interface {
+	Resource
+
+	// StateUpgraders returns the current Schema Version for this Resource
+	// and the State Upgrades required to get there
+	StateUpgraders() StateUpgradeData
+}
+
+type StateUpgradeData struct {
+	// SchemaVersion is the current version of the Schema for this Resource
+	// which must be the same as the number of Upgraders
+	SchemaVersion int
+
+	// Upgraders is a map of the Schema Version to the State Upgrade, used to
+	// upgrade from that version to the next (e.g. `0` upgrades from v0 to v1)
+	Upgraders map[int]pluginsdk.StateUpgrade
+}
+
 // ResourceWithDeprecation is an optional interface
 //
 // Resources implementing this interface will be marked as Deprecated
@@ -127,13 +161,17 @@ type ResourceMetaData struct {
 	// for example, to determine if a field has changes
 	ResourceData *schema.ResourceData
 
+	// ResourceDiff is a reference to the ResourceDiff object from Terraform's Plugin SDK
+	// This is only populated during CustomizeDiff, where ResourceData is unavailable
+	ResourceDiff *schema.ResourceDiff
+
 	// serializationDebugLogger is used for testing purposes
 	serializationDebugLogger Logger
 }
 
 // MarkAsGone marks this resource as removed in the Remote API, so this is no longer available
 func (rmd ResourceMetaData) MarkAsGone(idFormatter resourceid.Formatter) error {
-	rmd.Logger.Infof("[DEBUG] %s was not found - removing from state", idFormatter)
+	rmd.Logger.Debugf("%s was not found - removing from state", idFormatter)
 	rmd.ResourceData.SetId("")
 	return nil
 }
@@ -22,6 +22,17 @@ func (rmd ResourceMetaData) Decode(input interface{}) error {
 	return decodeReflectedType(input, rmd.ResourceData, rmd.serializationDebugLogger)
 }
 
+// DecodeDiff decodes the planned values from the ResourceDiff into the specified object
+// NOTE: this is intended for use within CustomizeDiff, where the ResourceData is unavailable
+// - unknown (computed) values will be decoded as their zero value.
+func (rmd ResourceMetaData) DecodeDiff(input interface{}) error {
+	if rmd.ResourceDiff == nil {
+		return fmt.Errorf("DecodeDiff is only available during CustomizeDiff")
+	}
+
+	return decodeReflectedType(input, rmd.ResourceDiff, rmd.serializationDebugLogger)
+}
+
 // stateRetriever is a convenience wrapper around the Plugin SDK to be able to test it more accurately
 type stateRetriever interface {
 	Get(key string) interface{}
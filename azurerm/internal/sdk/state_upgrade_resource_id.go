@@ -0,0 +1,163 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// ResourceIDParserFunc parses the specified Resource ID and returns a Formatter for it
+//
+// The parsers generated by `tools/generator-resource-id` can be used here by wrapping them, e.g.:
+//
+//	func(input string) (resourceid.Formatter, error) {
+//		 return parse.ServerIDInsensitively(input)
+//	}
+type ResourceIDParserFunc func(input string) (resourceid.Formatter, error)
+
+// ResourceIDRewrite defines how a Resource ID stored within a field should be rewritten
+type ResourceIDRewrite struct {
+	// OldParser parses the Resource ID as it exists in the State - the ID() of the
+	// Formatter returned from this function is used as the new value for this field.
+	//
+	// For Resource IDs which have changed casing, this can be the Insensitive Parser
+	// for this Resource ID - however where the segments within the Resource ID have
+	// changed, this needs to parse the old Resource ID and return the new Resource ID.
+	OldParser ResourceIDParserFunc
+
+	// NewParser (optional) parses the Resource ID in its current format, and is used
+	// to confirm that the rewritten Resource ID is valid.
+	NewParser ResourceIDParserFunc
+}
+
+var _ pluginsdk.StateUpgrade = ResourceIDStateUpgrade{}
+
+// ResourceIDStateUpgrade is a State Upgrade which rewrites the Resource ID's stored
+// within the specified fields - for example where the casing of a Resource ID has
+// changed between versions of the Provider.
+//
+// This implements the `pluginsdk.StateUpgrade` interface, and as such can be used
+// by both Typed Resources (via ResourceWithStateMigration) and Untyped Resources
+// (via `pluginsdk.StateUpgrades`).
+type ResourceIDStateUpgrade struct {
+	schema map[string]*pluginsdk.Schema
+	fields map[string]ResourceIDRewrite
+}
+
+// NewResourceIDStateUpgrade returns a State Upgrade which rewrites the Resource ID's for
+// the specified fields. The keys for `fields` are the path to the field within the
+// State, with nested fields separated by a `.` (e.g. `ip_configuration.subnet_id`) -
+// where a field is a List or Set each item within it will be rewritten.
+//
+// NOTE: `schema` is a point-in-time reference to the Schema at the time of this version
+func NewResourceIDStateUpgrade(schema map[string]*pluginsdk.Schema, fields map[string]ResourceIDRewrite) ResourceIDStateUpgrade {
+	return ResourceIDStateUpgrade{
+		schema: schema,
+		fields: fields,
+	}
+}
+
+func (u ResourceIDStateUpgrade) Schema() map[string]*pluginsdk.Schema {
+	return u.schema
+}
+
+func (u ResourceIDStateUpgrade) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		for path, rewrite := range u.fields {
+			if rewrite.OldParser == nil {
+				return nil, fmt.Errorf("an `OldParser` must be specified for the field %q", path)
+			}
+
+			if err := rewriteResourceIDsInState(rawState, strings.Split(path, "."), path, rewrite); err != nil {
+				return nil, err
+			}
+		}
+
+		return rawState, nil
+	}
+}
+
+func rewriteResourceIDsInState(input map[string]interface{}, segments []string, path string, rewrite ResourceIDRewrite) error {
+	key := segments[0]
+	raw, ok := input[key]
+	if !ok || raw == nil {
+		return nil
+	}
+
+	if len(segments) > 1 {
+		for _, item := range flattenRawStateItems(raw) {
+			nested, ok := item.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("expected %q within %q to be a nested block but got %T", key, path, item)
+			}
+
+			if err := rewriteResourceIDsInState(nested, segments[1:], path, rewrite); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	switch v := raw.(type) {
+	case string:
+		newId, err := rewriteResourceID(v, path, rewrite)
+		if err != nil {
+			return err
+		}
+		input[key] = newId
+
+	case []interface{}:
+		for i, item := range v {
+			oldId, ok := item.(string)
+			if !ok {
+				return fmt.Errorf("expected the items within %q to be strings but got %T", path, item)
+			}
+
+			newId, err := rewriteResourceID(oldId, path, rewrite)
+			if err != nil {
+				return err
+			}
+			v[i] = newId
+		}
+
+	default:
+		return fmt.Errorf("expected %q to be a string or a list of strings but got %T", path, raw)
+	}
+
+	return nil
+}
+
+func rewriteResourceID(oldId string, path string, rewrite ResourceIDRewrite) (string, error) {
+	// optional fields are stored as empty strings
+	if oldId == "" {
+		return oldId, nil
+	}
+
+	id, err := rewrite.OldParser(oldId)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q for %q: %+v", oldId, path, err)
+	}
+
+	newId := id.ID()
+	if rewrite.NewParser != nil {
+		if _, err := rewrite.NewParser(newId); err != nil {
+			return "", fmt.Errorf("validating rewritten Resource ID %q for %q: %+v", newId, path, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Updating %q from %q to %q", path, oldId, newId)
+	return newId, nil
+}
+
+func flattenRawStateItems(input interface{}) []interface{} {
+	if v, ok := input.([]interface{}); ok {
+		return v
+	}
+
+	return []interface{}{input}
+}
@@ -0,0 +1,277 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/desktopvirtualization/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+type resourceIDStateUpgradeTestData struct {
+	Name        string
+	Fields      map[string]ResourceIDRewrite
+	Input       map[string]interface{}
+	Expected    map[string]interface{}
+	ExpectError bool
+}
+
+var hostPoolCasingRewrite = ResourceIDRewrite{
+	OldParser: func(input string) (resourceid.Formatter, error) {
+		return parse.HostPoolIDInsensitively(input)
+	},
+	NewParser: func(input string) (resourceid.Formatter, error) {
+		return parse.HostPoolID(input)
+	},
+}
+
+func TestResourceIDStateUpgrade_TopLevel(t *testing.T) {
+	resourceIDStateUpgradeTestData{
+		Name: "Casing Drift",
+		Fields: map[string]ResourceIDRewrite{
+			"id": hostPoolCasingRewrite,
+		},
+		Input: map[string]interface{}{
+			"id":   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/hostpools/pool1",
+			"name": "pool1",
+		},
+		Expected: map[string]interface{}{
+			"id":   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/hostPools/pool1",
+			"name": "pool1",
+		},
+	}.test(t)
+
+	resourceIDStateUpgradeTestData{
+		Name: "Already Upgraded",
+		Fields: map[string]ResourceIDRewrite{
+			"id": hostPoolCasingRewrite,
+		},
+		Input: map[string]interface{}{
+			"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/hostPools/pool1",
+		},
+		Expected: map[string]interface{}{
+			"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/hostPools/pool1",
+		},
+	}.test(t)
+
+	resourceIDStateUpgradeTestData{
+		Name: "Optional Field Empty",
+		Fields: map[string]ResourceIDRewrite{
+			"host_pool_id": hostPoolCasingRewrite,
+		},
+		Input: map[string]interface{}{
+			"host_pool_id": "",
+		},
+		Expected: map[string]interface{}{
+			"host_pool_id": "",
+		},
+	}.test(t)
+
+	resourceIDStateUpgradeTestData{
+		Name: "Field Missing",
+		Fields: map[string]ResourceIDRewrite{
+			"host_pool_id": hostPoolCasingRewrite,
+		},
+		Input: map[string]interface{}{
+			"name": "pool1",
+		},
+		Expected: map[string]interface{}{
+			"name": "pool1",
+		},
+	}.test(t)
+
+	resourceIDStateUpgradeTestData{
+		Name: "Invalid Resource ID",
+		Fields: map[string]ResourceIDRewrite{
+			"id": hostPoolCasingRewrite,
+		},
+		Input: map[string]interface{}{
+			"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+		},
+		ExpectError: true,
+	}.test(t)
+
+	resourceIDStateUpgradeTestData{
+		Name: "No Old Parser",
+		Fields: map[string]ResourceIDRewrite{
+			"id": {},
+		},
+		Input: map[string]interface{}{
+			"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/hostPools/pool1",
+		},
+		ExpectError: true,
+	}.test(t)
+}
+
+func TestResourceIDStateUpgrade_SegmentRenamed(t *testing.T) {
+	resourceIDStateUpgradeTestData{
+		Name: "Segment Renamed",
+		Fields: map[string]ResourceIDRewrite{
+			"id": {
+				OldParser: func(input string) (resourceid.Formatter, error) {
+					// parse the previous Resource ID and build the current Resource ID from its segments
+					old, err := parse.HostPoolIDInsensitively(input)
+					if err != nil {
+						return nil, err
+					}
+					id := parse.NewHostPoolID(old.SubscriptionId, old.ResourceGroup, old.Name)
+					return id, nil
+				},
+				NewParser: func(input string) (resourceid.Formatter, error) {
+					return parse.HostPoolID(input)
+				},
+			},
+		},
+		Input: map[string]interface{}{
+			"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/HOSTPOOLS/pool1",
+		},
+		Expected: map[string]interface{}{
+			"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/hostPools/pool1",
+		},
+	}.test(t)
+
+	resourceIDStateUpgradeTestData{
+		Name: "Rewritten ID Invalid",
+		Fields: map[string]ResourceIDRewrite{
+			"id": {
+				OldParser: func(input string) (resourceid.Formatter, error) {
+					return parse.HostPoolIDInsensitively(input)
+				},
+				NewParser: func(input string) (resourceid.Formatter, error) {
+					return nil, fmt.Errorf("this is never valid")
+				},
+			},
+		},
+		Input: map[string]interface{}{
+			"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/hostPools/pool1",
+		},
+		ExpectError: true,
+	}.test(t)
+}
+
+func TestResourceIDStateUpgrade_Lists(t *testing.T) {
+	resourceIDStateUpgradeTestData{
+		Name: "List of Resource IDs",
+		Fields: map[string]ResourceIDRewrite{
+			"host_pool_ids": hostPoolCasingRewrite,
+		},
+		Input: map[string]interface{}{
+			"host_pool_ids": []interface{}{
+				"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/hostpools/pool1",
+				"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/HostPools/pool2",
+			},
+		},
+		Expected: map[string]interface{}{
+			"host_pool_ids": []interface{}{
+				"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/hostPools/pool1",
+				"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/hostPools/pool2",
+			},
+		},
+	}.test(t)
+
+	resourceIDStateUpgradeTestData{
+		Name: "Nested Blocks",
+		Fields: map[string]ResourceIDRewrite{
+			"assignment.host_pool_id": hostPoolCasingRewrite,
+		},
+		Input: map[string]interface{}{
+			"assignment": []interface{}{
+				map[string]interface{}{
+					"name":         "first",
+					"host_pool_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/hostpools/pool1",
+				},
+				map[string]interface{}{
+					"name":         "second",
+					"host_pool_id": "",
+				},
+			},
+		},
+		Expected: map[string]interface{}{
+			"assignment": []interface{}{
+				map[string]interface{}{
+					"name":         "first",
+					"host_pool_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/hostPools/pool1",
+				},
+				map[string]interface{}{
+					"name":         "second",
+					"host_pool_id": "",
+				},
+			},
+		},
+	}.test(t)
+
+	resourceIDStateUpgradeTestData{
+		Name: "Nested Field Not A Block",
+		Fields: map[string]ResourceIDRewrite{
+			"name.host_pool_id": hostPoolCasingRewrite,
+		},
+		Input: map[string]interface{}{
+			"name": "pool1",
+		},
+		ExpectError: true,
+	}.test(t)
+}
+
+func TestResourceIDStateUpgrade_Typed(t *testing.T) {
+	upgrade := NewResourceIDStateUpgrade(map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+	}, map[string]ResourceIDRewrite{
+		"id": hostPoolCasingRewrite,
+	})
+
+	wrapper := NewResourceWrapper(wrapperTestResourceWithStateMigration{
+		upgrades: StateUpgradeData{
+			SchemaVersion: 1,
+			Upgraders: map[int]pluginsdk.StateUpgrade{
+				0: upgrade,
+			},
+		},
+	})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	input := map[string]interface{}{
+		"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/hostpools/pool1",
+	}
+	expected := map[string]interface{}{
+		"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DesktopVirtualization/hostPools/pool1",
+	}
+	actual, err := resource.StateUpgraders[0].Upgrade(context.TODO(), input, nil)
+	if err != nil {
+		t.Fatalf("upgrading state: %+v", err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func (testData resourceIDStateUpgradeTestData) test(t *testing.T) {
+	t.Logf("[DEBUG] Testing %q", testData.Name)
+
+	upgrade := NewResourceIDStateUpgrade(map[string]*pluginsdk.Schema{}, testData.Fields)
+	actual, err := upgrade.UpgradeFunc()(context.TODO(), testData.Input, nil)
+	if err != nil {
+		if testData.ExpectError {
+			// we're good
+			return
+		}
+
+		t.Fatalf("upgrading state: %+v", err)
+	}
+	if testData.ExpectError {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	if !reflect.DeepEqual(actual, testData.Expected) {
+		t.Fatalf("expected %+v but got %+v", testData.Expected, actual)
+	}
+}
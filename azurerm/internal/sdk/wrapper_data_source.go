@@ -17,9 +17,12 @@ type DataSourceWrapper struct {
 
 // NewDataSourceWrapper returns a DataSourceWrapper for this Data Source implementation
 func NewDataSourceWrapper(dataSource DataSource) DataSourceWrapper {
+	logger := &DiagnosticsLogger{}
 	return DataSourceWrapper{
 		dataSource: dataSource,
-		logger:     &DiagnosticsLogger{},
+		logger: logger.WithFields(LogFields{
+			LogFieldResourceType: dataSource.ResourceType(),
+		}),
 	}
 }
 
@@ -48,10 +48,42 @@ func runArgs(d *schema.ResourceData, meta interface{}, logger Logger) ResourceMe
 	client := meta.(*clients.Client)
 	metaData := ResourceMetaData{
 		Client:                   client,
-		Logger:                   logger,
+		Logger:                   withResourceLogFields(logger, client, d.Id),
 		ResourceData:             d,
 		serializationDebugLogger: NullLogger{},
 	}
 
 	return metaData
 }
+
+func runDiffArgs(d *schema.ResourceDiff, meta interface{}, logger Logger) ResourceMetaData {
+	client := meta.(*clients.Client)
+	metaData := ResourceMetaData{
+		Client:                   client,
+		Logger:                   withResourceLogFields(logger, client, d.Id),
+		ResourceDiff:             d,
+		serializationDebugLogger: NullLogger{},
+	}
+
+	return metaData
+}
+
+// withResourceLogFields returns a Logger which includes the Resource ID and the Correlation Request ID
+// in each message - the Resource ID is looked up when logging, since this is unknown prior to Create
+func withResourceLogFields(logger Logger, client *clients.Client, resourceId func() string) Logger {
+	fields := LogFields{
+		LogFieldResourceID: logFieldFunc(resourceId),
+	}
+	if client != nil && client.CorrelationRequestID != "" {
+		fields[LogFieldCorrelationRequestID] = client.CorrelationRequestID
+	}
+
+	return logger.WithFields(fields)
+}
+
+// logFieldFunc is a LogField value which is evaluated when the message is written
+type logFieldFunc func() string
+
+func (f logFieldFunc) String() string {
+	return f()
+}
@@ -20,8 +20,11 @@ type ResourceWrapper struct {
 
 // NewResourceWrapper returns a ResourceWrapper for this Resource implementation
 func NewResourceWrapper(resource Resource) ResourceWrapper {
+	logger := &DiagnosticsLogger{}
 	return ResourceWrapper{
-		logger:   &DiagnosticsLogger{},
+		logger: logger.WithFields(LogFields{
+			LogFieldResourceType: resource.ResourceType(),
+		}),
 		resource: resource,
 	}
 }
@@ -132,12 +135,47 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		resource.DeprecationMessage = message
 	}
 
-	// TODO: CustomizeDiff
-	// TODO: State Migrations
+	if v, ok := rw.resource.(ResourceWithCustomizeDiff); ok {
+		resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			metaData := runDiffArgs(d, meta, rw.logger)
+			return v.CustomizeDiff().Func(ctx, metaData)
+		}
+	}
+
+	if v, ok := rw.resource.(ResourceWithStateMigration); ok {
+		upgrades := v.StateUpgraders()
+		if err := validateStateUpgrades(upgrades); err != nil {
+			return nil, fmt.Errorf("validating State Upgrades for %q: %+v", rw.resource.ResourceType(), err)
+		}
+
+		resource.SchemaVersion = upgrades.SchemaVersion
+		resource.StateUpgraders = pluginsdk.StateUpgrades(upgrades.Upgraders)
+	}
 
 	return &resource, nil
 }
 
+// validateStateUpgrades ensures there's a State Upgrade for each Schema Version
+// prior to the current one - since the Plugin SDK requires that these are sequential
+func validateStateUpgrades(input StateUpgradeData) error {
+	if input.SchemaVersion < 0 {
+		return fmt.Errorf("`SchemaVersion` must be 0 or greater but got %d", input.SchemaVersion)
+	}
+
+	if len(input.Upgraders) != input.SchemaVersion {
+		return fmt.Errorf("expected %d State Upgraders for Schema Version %d but got %d", input.SchemaVersion, input.SchemaVersion, len(input.Upgraders))
+	}
+
+	for version := 0; version < input.SchemaVersion; version++ {
+		upgrader, ok := input.Upgraders[version]
+		if !ok || upgrader == nil {
+			return fmt.Errorf("missing State Upgrader for Schema Version %d", version)
+		}
+	}
+
+	return nil
+}
+
 func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return diagnosticsWrapper(in, rw.logger)
 }
@@ -155,7 +193,7 @@ func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, met
 		}
 
 		if diagsLogger, ok := logger.(*DiagnosticsLogger); ok {
-			out = append(out, diagsLogger.diagnostics...)
+			out = append(out, diagsLogger.root().diagnostics...)
 		}
 
 		return out
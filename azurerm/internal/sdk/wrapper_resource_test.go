@@ -0,0 +1,360 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+type wrapperTestModel struct {
+	Name  string `tfschema:"name"`
+	Count int    `tfschema:"count"`
+}
+
+type wrapperTestResource struct{}
+
+func (wrapperTestResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+		"count": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+		},
+	}
+}
+
+func (wrapperTestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (wrapperTestResource) ModelObject() interface{} {
+	return &wrapperTestModel{}
+}
+
+func (wrapperTestResource) ResourceType() string {
+	return "validator_wrapper"
+}
+
+func (wrapperTestResource) Create() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (wrapperTestResource) Read() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (wrapperTestResource) Delete() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (wrapperTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return nil
+}
+
+type wrapperTestResourceWithCustomizeDiff struct {
+	wrapperTestResource
+}
+
+func (wrapperTestResourceWithCustomizeDiff) CustomizeDiff() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			var model wrapperTestModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return err
+			}
+
+			if model.Name == "legacy" && model.Count > 1 {
+				return fmt.Errorf("`count` must be 1 when `name` is %q", model.Name)
+			}
+
+			return nil
+		},
+	}
+}
+
+func TestResourceWrapper_CustomizeDiffNotImplemented(t *testing.T) {
+	wrapper := NewResourceWrapper(wrapperTestResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	if resource.CustomizeDiff != nil {
+		t.Fatalf("expected CustomizeDiff to be nil when ResourceWithCustomizeDiff isn't implemented")
+	}
+}
+
+func TestResourceWrapper_CustomizeDiff(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Config      map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			Name: "Valid",
+			Config: map[string]interface{}{
+				"name":  "legacy",
+				"count": 1,
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "Valid - Different Name",
+			Config: map[string]interface{}{
+				"name":  "modern",
+				"count": 3,
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "Invalid",
+			Config: map[string]interface{}{
+				"name":  "legacy",
+				"count": 3,
+			},
+			ExpectError: true,
+		},
+	}
+
+	wrapper := NewResourceWrapper(wrapperTestResourceWithCustomizeDiff{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	if resource.CustomizeDiff == nil {
+		t.Fatalf("expected CustomizeDiff to be set when ResourceWithCustomizeDiff is implemented")
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		config := terraform.NewResourceConfigRaw(v.Config)
+		_, err := resource.Diff(context.TODO(), nil, config, &clients.Client{})
+		if v.ExpectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
+
+type wrapperTestStateUpgradeV0ToV1 struct{}
+
+func (wrapperTestStateUpgradeV0ToV1) Schema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+		"number": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+		},
+	}
+}
+
+func (wrapperTestStateUpgradeV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		rawState["count"] = rawState["number"]
+		delete(rawState, "number")
+		return rawState, nil
+	}
+}
+
+type wrapperTestResourceWithStateMigration struct {
+	wrapperTestResource
+	upgrades StateUpgradeData
+}
+
+func (r wrapperTestResourceWithStateMigration) StateUpgraders() StateUpgradeData {
+	return r.upgrades
+}
+
+func TestResourceWrapper_StateMigrationNotImplemented(t *testing.T) {
+	wrapper := NewResourceWrapper(wrapperTestResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	if resource.SchemaVersion != 0 {
+		t.Fatalf("expected SchemaVersion to be 0 but got %d", resource.SchemaVersion)
+	}
+	if len(resource.StateUpgraders) != 0 {
+		t.Fatalf("expected no StateUpgraders but got %d", len(resource.StateUpgraders))
+	}
+}
+
+func TestResourceWrapper_StateMigrationValidation(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Input       StateUpgradeData
+		ExpectError bool
+	}{
+		{
+			Name: "No Upgrades",
+			Input: StateUpgradeData{
+				SchemaVersion: 0,
+				Upgraders:     map[int]pluginsdk.StateUpgrade{},
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "Single Upgrade",
+			Input: StateUpgradeData{
+				SchemaVersion: 1,
+				Upgraders: map[int]pluginsdk.StateUpgrade{
+					0: wrapperTestStateUpgradeV0ToV1{},
+				},
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "Multiple Upgrades",
+			Input: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]pluginsdk.StateUpgrade{
+					0: wrapperTestStateUpgradeV0ToV1{},
+					1: wrapperTestStateUpgradeV0ToV1{},
+				},
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "Schema Version Ahead of Upgrades",
+			Input: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]pluginsdk.StateUpgrade{
+					0: wrapperTestStateUpgradeV0ToV1{},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Schema Version Behind Upgrades",
+			Input: StateUpgradeData{
+				SchemaVersion: 0,
+				Upgraders: map[int]pluginsdk.StateUpgrade{
+					0: wrapperTestStateUpgradeV0ToV1{},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Missing Version",
+			Input: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]pluginsdk.StateUpgrade{
+					0: wrapperTestStateUpgradeV0ToV1{},
+					2: wrapperTestStateUpgradeV0ToV1{},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Nil Upgrade",
+			Input: StateUpgradeData{
+				SchemaVersion: 1,
+				Upgraders: map[int]pluginsdk.StateUpgrade{
+					0: nil,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Negative Schema Version",
+			Input: StateUpgradeData{
+				SchemaVersion: -1,
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		wrapper := NewResourceWrapper(wrapperTestResourceWithStateMigration{
+			upgrades: v.Input,
+		})
+		resource, err := wrapper.Resource()
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if resource.SchemaVersion != v.Input.SchemaVersion {
+			t.Fatalf("expected SchemaVersion to be %d but got %d", v.Input.SchemaVersion, resource.SchemaVersion)
+		}
+		if len(resource.StateUpgraders) != len(v.Input.Upgraders) {
+			t.Fatalf("expected %d StateUpgraders but got %d", len(v.Input.Upgraders), len(resource.StateUpgraders))
+		}
+		for i, upgrader := range resource.StateUpgraders {
+			if upgrader.Version != i {
+				t.Fatalf("expected StateUpgrader %d to have Version %d but got %d", i, i, upgrader.Version)
+			}
+		}
+	}
+}
+
+func TestResourceWrapper_StateMigrationUpgrade(t *testing.T) {
+	wrapper := NewResourceWrapper(wrapperTestResourceWithStateMigration{
+		upgrades: StateUpgradeData{
+			SchemaVersion: 1,
+			Upgraders: map[int]pluginsdk.StateUpgrade{
+				0: wrapperTestStateUpgradeV0ToV1{},
+			},
+		},
+	})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	input := map[string]interface{}{
+		"name":   "example",
+		"number": 3,
+	}
+	expected := map[string]interface{}{
+		"name":  "example",
+		"count": 3,
+	}
+	actual, err := resource.StateUpgraders[0].Upgrade(context.TODO(), input, &clients.Client{})
+	if err != nil {
+		t.Fatalf("upgrading state: %+v", err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
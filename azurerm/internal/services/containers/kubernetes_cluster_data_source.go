@@ -271,6 +271,10 @@ func dataSourceKubernetesCluster() *pluginsdk.Resource {
 						},
 
 						"upgrade_settings": upgradeSettingsForDataSourceSchema(),
+
+						"kubelet_config": schemaNodePoolKubeletConfigForDataSource(),
+
+						"linux_os_config": schemaNodePoolLinuxOSConfigForDataSource(),
 					},
 				},
 			},
@@ -298,6 +302,38 @@ func dataSourceKubernetesCluster() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"http_proxy_config": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"http_proxy": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"https_proxy": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"no_proxy": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"trusted_ca": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
 			"private_link_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -533,6 +569,83 @@ func dataSourceKubernetesCluster() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"pod_identity_profile": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allow_network_plugin_kubenet": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"user_assigned_identity": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"namespace": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"binding_selector": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"client_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"object_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"user_assigned_identity_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"user_assigned_identity_exception": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"namespace": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"pod_labels": {
+										Type:     pluginsdk.TypeMap,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"role_based_access_control": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -653,11 +766,19 @@ func dataSourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{}
 			return fmt.Errorf("Error setting `addon_profile`: %+v", err)
 		}
 
-		agentPoolProfiles := flattenKubernetesClusterDataSourceAgentPoolProfiles(props.AgentPoolProfiles)
+		agentPoolProfiles, err := flattenKubernetesClusterDataSourceAgentPoolProfiles(props.AgentPoolProfiles)
+		if err != nil {
+			return fmt.Errorf("flattening `agent_pool_profile`: %+v", err)
+		}
 		if err := d.Set("agent_pool_profile", agentPoolProfiles); err != nil {
 			return fmt.Errorf("Error setting `agent_pool_profile`: %+v", err)
 		}
 
+		httpProxyConfig := flattenKubernetesClusterDataSourceHTTPProxyConfig(props.HTTPProxyConfig)
+		if err := d.Set("http_proxy_config", httpProxyConfig); err != nil {
+			return fmt.Errorf("setting `http_proxy_config`: %+v", err)
+		}
+
 		kubeletIdentity, err := flattenKubernetesClusterDataSourceIdentityProfile(props.IdentityProfile)
 		if err != nil {
 			return err
@@ -681,6 +802,14 @@ func dataSourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{}
 			return fmt.Errorf("Error setting `network_profile`: %+v", err)
 		}
 
+		podIdentityProfile, err := flattenKubernetesClusterPodIdentityProfile(props.PodIdentityProfile)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("pod_identity_profile", podIdentityProfile); err != nil {
+			return fmt.Errorf("setting `pod_identity_profile`: %+v", err)
+		}
+
 		roleBasedAccessControl := flattenKubernetesClusterDataSourceRoleBasedAccessControl(props)
 		if err := d.Set("role_based_access_control", roleBasedAccessControl); err != nil {
 			return fmt.Errorf("Error setting `role_based_access_control`: %+v", err)
@@ -962,11 +1091,11 @@ func flattenKubernetesClusterDataSourceAddOnIdentityProfile(profile *containerse
 	return identity, nil
 }
 
-func flattenKubernetesClusterDataSourceAgentPoolProfiles(input *[]containerservice.ManagedClusterAgentPoolProfile) []interface{} {
+func flattenKubernetesClusterDataSourceAgentPoolProfiles(input *[]containerservice.ManagedClusterAgentPoolProfile) ([]interface{}, error) {
 	agentPoolProfiles := make([]interface{}, 0)
 
 	if input == nil {
-		return agentPoolProfiles
+		return agentPoolProfiles, nil
 	}
 
 	for _, profile := range *input {
@@ -1046,11 +1175,18 @@ func flattenKubernetesClusterDataSourceAgentPoolProfiles(input *[]containerservi
 			vmSize = *profile.VMSize
 		}
 
+		linuxOSConfig, err := flattenAgentPoolLinuxOSConfig(profile.LinuxOSConfig)
+		if err != nil {
+			return nil, err
+		}
+
 		agentPoolProfiles = append(agentPoolProfiles, map[string]interface{}{
 			"availability_zones":       utils.FlattenStringSlice(profile.AvailabilityZones),
 			"count":                    count,
 			"enable_auto_scaling":      enableAutoScaling,
 			"enable_node_public_ip":    enableNodePublicIP,
+			"kubelet_config":           flattenAgentPoolKubeletConfig(profile.KubeletConfig),
+			"linux_os_config":          linuxOSConfig,
 			"max_count":                maxCount,
 			"max_pods":                 maxPods,
 			"min_count":                minCount,
@@ -1069,7 +1205,37 @@ func flattenKubernetesClusterDataSourceAgentPoolProfiles(input *[]containerservi
 		})
 	}
 
-	return agentPoolProfiles
+	return agentPoolProfiles, nil
+}
+
+func flattenKubernetesClusterDataSourceHTTPProxyConfig(input *containerservice.ManagedClusterHTTPProxyConfig) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	httpProxy := ""
+	if input.HTTPProxy != nil {
+		httpProxy = *input.HTTPProxy
+	}
+
+	httpsProxy := ""
+	if input.HTTPSProxy != nil {
+		httpsProxy = *input.HTTPSProxy
+	}
+
+	trustedCa := ""
+	if input.TrustedCa != nil {
+		trustedCa = *input.TrustedCa
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"http_proxy":  httpProxy,
+			"https_proxy": httpsProxy,
+			"no_proxy":    utils.FlattenStringSlice(input.NoProxy),
+			"trusted_ca":  trustedCa,
+		},
+	}
 }
 
 func flattenKubernetesClusterDataSourceIdentityProfile(profile map[string]*containerservice.ManagedClusterPropertiesIdentityProfileValue) ([]interface{}, error) {
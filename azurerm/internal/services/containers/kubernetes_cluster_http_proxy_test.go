@@ -0,0 +1,62 @@
+package containers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-03-01/containerservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenKubernetesClusterHTTPProxyConfig(t *testing.T) {
+	// AKS appends the addresses used within the Cluster to those specified in `no_proxy`
+	input := &containerservice.ManagedClusterHTTPProxyConfig{
+		HTTPProxy: utils.String("http://proxy.example.com:8080/"),
+		NoProxy: &[]string{
+			"localhost",
+			"10.0.0.0/16",
+			"konnectivity",
+		},
+	}
+
+	testCases := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Expected []interface{}
+	}{
+		{
+			Name: "No `no_proxy` configured",
+			Config: map[string]interface{}{
+				"http_proxy": "http://proxy.example.com:8080/",
+			},
+			Expected: []interface{}{},
+		},
+		{
+			Name: "Subset of `no_proxy` configured",
+			Config: map[string]interface{}{
+				"http_proxy": "http://proxy.example.com:8080/",
+				"no_proxy":   []interface{}{"localhost"},
+			},
+			Expected: []interface{}{"localhost"},
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		d := schema.TestResourceDataRaw(t, resourceKubernetesCluster().Schema, map[string]interface{}{
+			"http_proxy_config": []interface{}{v.Config},
+		})
+
+		actual := flattenKubernetesClusterHTTPProxyConfig(input, d)
+		if len(actual) != 1 {
+			t.Fatalf("expected a single `http_proxy_config` block but got %d", len(actual))
+		}
+
+		noProxy := actual[0].(map[string]interface{})["no_proxy"]
+		if !reflect.DeepEqual(noProxy, v.Expected) {
+			t.Fatalf("expected `no_proxy` to be %+v but got %+v", v.Expected, noProxy)
+		}
+	}
+}
@@ -62,6 +62,10 @@ func dataSourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"kubelet_config": schemaNodePoolKubeletConfigForDataSource(),
+
+			"linux_os_config": schemaNodePoolLinuxOSConfigForDataSource(),
+
 			"max_count": {
 				Type:     pluginsdk.TypeInt,
 				Computed: true,
@@ -123,11 +127,21 @@ func dataSourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"os_sku": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"os_type": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
 
+			"pod_subnet_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"priority": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -212,6 +226,18 @@ func dataSourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta int
 		}
 		d.Set("eviction_policy", evictionPolicy)
 
+		if err := d.Set("kubelet_config", flattenAgentPoolKubeletConfig(props.KubeletConfig)); err != nil {
+			return fmt.Errorf("setting `kubelet_config`: %+v", err)
+		}
+
+		linuxOSConfig, err := flattenAgentPoolLinuxOSConfig(props.LinuxOSConfig)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("linux_os_config", linuxOSConfig); err != nil {
+			return fmt.Errorf("setting `linux_os_config`: %+v", err)
+		}
+
 		maxCount := 0
 		if props.MaxCount != nil {
 			maxCount = int(*props.MaxCount)
@@ -264,7 +290,9 @@ func dataSourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta int
 			osDiskType = props.OsDiskType
 		}
 		d.Set("os_disk_type", string(osDiskType))
+		d.Set("os_sku", string(props.OsSKU))
 		d.Set("os_type", string(props.OsType))
+		d.Set("pod_subnet_id", props.PodSubnetID)
 
 		// not returned from the API if not Spot
 		priority := string(containerservice.ScaleSetPriorityRegular)
@@ -12,7 +12,8 @@ type KubernetesClusterNodePoolDataSource struct {
 }
 
 var kubernetesNodePoolDataSourceTests = map[string]func(t *testing.T){
-	"basic": testAccKubernetesClusterNodePoolDataSource_basic,
+	"basic":                   testAccKubernetesClusterNodePoolDataSource_basic,
+	"kubeletAndLinuxOSConfig": testAccKubernetesClusterNodePoolDataSource_kubeletAndLinuxOSConfig,
 }
 
 func TestAccKubernetesClusterNodePoolDataSource_basic(t *testing.T) {
@@ -36,6 +37,28 @@ func testAccKubernetesClusterNodePoolDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesClusterNodePoolDataSource_kubeletAndLinuxOSConfig(t *testing.T) {
+	checkIfShouldRunTestsIndividually(t)
+	testAccKubernetesClusterNodePoolDataSource_kubeletAndLinuxOSConfig(t)
+}
+
+func testAccKubernetesClusterNodePoolDataSource_kubeletAndLinuxOSConfig(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_cluster_node_pool", "test")
+	r := KubernetesClusterNodePoolDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.kubeletAndLinuxOSConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("kubelet_config.0.cpu_manager_policy").HasValue("static"),
+				check.That(data.ResourceName).Key("kubelet_config.0.allowed_unsafe_sysctls.#").HasValue("2"),
+				check.That(data.ResourceName).Key("linux_os_config.0.swap_file_size_mb").HasValue("300"),
+				check.That(data.ResourceName).Key("linux_os_config.0.sysctl_config.0.vm_swappiness").HasValue("45"),
+			),
+		},
+	})
+}
+
 func (KubernetesClusterNodePoolDataSource) basicConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -47,3 +70,15 @@ data "azurerm_kubernetes_cluster_node_pool" "test" {
 }
 `, KubernetesClusterNodePoolResource{}.manualScaleConfig(data))
 }
+
+func (KubernetesClusterNodePoolDataSource) kubeletAndLinuxOSConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                    = azurerm_kubernetes_cluster_node_pool.test.name
+  kubernetes_cluster_name = azurerm_kubernetes_cluster.test.name
+  resource_group_name     = azurerm_kubernetes_cluster.test.resource_group_name
+}
+`, KubernetesClusterNodePoolResource{}.kubeletAndLinuxOSConfig(data))
+}
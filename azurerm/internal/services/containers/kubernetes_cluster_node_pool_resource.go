@@ -192,6 +192,17 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"os_sku": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true, // defaults to Ubuntu if using Linux
+				ValidateFunc: validation.StringInSlice([]string{
+					string(containerservice.OSSKUUbuntu),
+					string(containerservice.OSSKUCBLMariner),
+				}, false),
+			},
+
 			"os_type": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -203,6 +214,14 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"pod_subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+				RequiredWith: []string{"vnet_subnet_id"},
+			},
+
 			"priority": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -379,10 +398,25 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		profile.OsDiskType = containerservice.OSDiskType(osDiskType)
 	}
 
+	if osSku := d.Get("os_sku").(string); osSku != "" {
+		if osType != string(containerservice.OSTypeLinux) {
+			return fmt.Errorf("`os_sku` can only be configured when `os_type` is set to `linux`")
+		}
+		profile.OsSKU = containerservice.OSSKU(osSku)
+	}
+
 	if vnetSubnetID := d.Get("vnet_subnet_id").(string); vnetSubnetID != "" {
 		profile.VnetSubnetID = utils.String(vnetSubnetID)
 	}
 
+	podSubnetID := d.Get("pod_subnet_id").(string)
+	if err := validateNodePoolPodSubnetID(cluster, name, podSubnetID); err != nil {
+		return err
+	}
+	if podSubnetID != "" {
+		profile.PodSubnetID = utils.String(podSubnetID)
+	}
+
 	maxCount := d.Get("max_count").(int)
 	minCount := d.Get("min_count").(int)
 
@@ -718,7 +752,9 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 			osDiskType = props.OsDiskType
 		}
 		d.Set("os_disk_type", osDiskType)
+		d.Set("os_sku", string(props.OsSKU))
 		d.Set("os_type", string(props.OsType))
+		d.Set("pod_subnet_id", props.PodSubnetID)
 
 		// not returned from the API if not Spot
 		priority := string(containerservice.ScaleSetPriorityRegular)
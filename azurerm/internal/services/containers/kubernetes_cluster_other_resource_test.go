@@ -2,6 +2,7 @@ package containers_test
 
 import (
 	"fmt"
+	"os"
 	"strings"
 	"testing"
 
@@ -31,6 +32,9 @@ var kubernetesOtherTests = map[string]func(t *testing.T){
 	"privateClusterPrivateDNSAndSP":     testAccKubernetesCluster_privateClusterOnWithPrivateDNSZoneAndServicePrincipal,
 	"privateClusterPrivateDNSSubDomain": testAccKubernetesCluster_privateClusterOnWithPrivateDNSZoneSubDomain,
 	"upgradeChannel":                    testAccKubernetesCluster_upgradeChannel,
+	"maintenanceConfig":                 testAccKubernetesCluster_maintenanceConfig,
+	"httpProxyConfig":                   testAccKubernetesCluster_httpProxyConfig,
+	"podIdentityProfile":                testAccKubernetesCluster_podIdentityProfile,
 }
 
 func TestAccKubernetesCluster_basicAvailabilitySet(t *testing.T) {
@@ -502,6 +506,108 @@ func testAccKubernetesCluster_upgradeChannel(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCluster_maintenanceConfig(t *testing.T) {
+	checkIfShouldRunTestsIndividually(t)
+	testAccKubernetesCluster_maintenanceConfig(t)
+}
+
+func testAccKubernetesCluster_maintenanceConfig(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.maintenanceConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maintenance_window.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.maintenanceConfigUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maintenance_window.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.upgradeChannelConfig(data, olderKubernetesVersion, ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maintenance_window.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesCluster_httpProxyConfig(t *testing.T) {
+	checkIfShouldRunTestsIndividually(t)
+	testAccKubernetesCluster_httpProxyConfig(t)
+}
+
+func testAccKubernetesCluster_httpProxyConfig(t *testing.T) {
+	if os.Getenv("ARM_TEST_HTTP_PROXY") == "" {
+		t.Skip("Skipping as ARM_TEST_HTTP_PROXY is not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.httpProxyConfig(data, os.Getenv("ARM_TEST_HTTP_PROXY")),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("http_proxy_config.0.no_proxy.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("http_proxy_config.0.no_proxy", "http_proxy_config.0.trusted_ca"),
+	})
+}
+
+func TestAccKubernetesCluster_podIdentityProfile(t *testing.T) {
+	checkIfShouldRunTestsIndividually(t)
+	testAccKubernetesCluster_podIdentityProfile(t)
+}
+
+func testAccKubernetesCluster_podIdentityProfile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.podIdentityProfileConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pod_identity_profile.0.user_assigned_identity.#").HasValue("1"),
+				check.That(data.ResourceName).Key("pod_identity_profile.0.user_assigned_identity_exception.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.podIdentityProfileUpdatedConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pod_identity_profile.0.user_assigned_identity.0.binding_selector").HasValue("acctest"),
+				check.That(data.ResourceName).Key("pod_identity_profile.0.user_assigned_identity_exception.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.podIdentityProfileTemplateConfig(data, ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pod_identity_profile.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (KubernetesClusterResource) basicAvailabilitySetConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -1438,3 +1544,213 @@ resource "azurerm_kubernetes_cluster" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, controlPlaneVersion, upgradeChannel)
 }
+
+func (KubernetesClusterResource) maintenanceConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+  kubernetes_version  = %q
+
+  default_node_pool {
+    name       = "default"
+    vm_size    = "Standard_DS2_v2"
+    node_count = 1
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  maintenance_window {
+    allowed {
+      day   = "Monday"
+      hours = [1, 2]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, olderKubernetesVersion)
+}
+
+func (KubernetesClusterResource) maintenanceConfigUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+  kubernetes_version  = %q
+
+  default_node_pool {
+    name       = "default"
+    vm_size    = "Standard_DS2_v2"
+    node_count = 1
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  maintenance_window {
+    allowed {
+      day   = "Saturday"
+      hours = [1, 2, 3]
+    }
+
+    allowed {
+      day   = "Sunday"
+      hours = [0, 1]
+    }
+
+    not_allowed {
+      end   = "2021-11-30T12:00:00Z"
+      start = "2021-11-26T03:00:00Z"
+    }
+
+    not_allowed {
+      end   = "2021-12-02T12:00:00+01:00"
+      start = "2021-12-01T03:00:00+01:00"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, olderKubernetesVersion)
+}
+
+func (KubernetesClusterResource) httpProxyConfig(data acceptance.TestData, proxy string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  http_proxy_config {
+    http_proxy  = %q
+    https_proxy = %q
+    no_proxy    = ["localhost", "127.0.0.1"]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, proxy, proxy)
+}
+
+func (r KubernetesClusterResource) podIdentityProfileConfig(data acceptance.TestData) string {
+	return r.podIdentityProfileTemplateConfig(data, `
+  pod_identity_profile {
+    allow_network_plugin_kubenet = true
+
+    user_assigned_identity {
+      name                      = "acctest"
+      namespace                 = "default"
+      client_id                 = azurerm_user_assigned_identity.test.client_id
+      object_id                 = azurerm_user_assigned_identity.test.principal_id
+      user_assigned_identity_id = azurerm_user_assigned_identity.test.id
+    }
+
+    user_assigned_identity_exception {
+      name      = "acctest"
+      namespace = "default"
+
+      pod_labels = {
+        app = "acctest"
+      }
+    }
+  }
+`)
+}
+
+func (r KubernetesClusterResource) podIdentityProfileUpdatedConfig(data acceptance.TestData) string {
+	return r.podIdentityProfileTemplateConfig(data, `
+  pod_identity_profile {
+    allow_network_plugin_kubenet = true
+
+    user_assigned_identity {
+      name                      = "acctest"
+      namespace                 = "default"
+      binding_selector          = "acctest"
+      client_id                 = azurerm_user_assigned_identity.test.client_id
+      object_id                 = azurerm_user_assigned_identity.test.principal_id
+      user_assigned_identity_id = azurerm_user_assigned_identity.test.id
+    }
+  }
+`)
+}
+
+func (KubernetesClusterResource) podIdentityProfileTemplateConfig(data acceptance.TestData, podIdentityProfile string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  network_profile {
+    network_plugin = "kubenet"
+  }
+%s
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, podIdentityProfile)
+}
@@ -1,6 +1,7 @@
 package containers
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-03-01/containerservice"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -28,6 +30,10 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// kubernetesClusterMaintenanceConfigurationName is the name of the Maintenance Configuration used for
+// Planned Maintenance, since AKS only supports a single (`default`) Maintenance Configuration
+const kubernetesClusterMaintenanceConfigurationName = "default"
+
 func resourceKubernetesCluster() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceKubernetesClusterCreate,
@@ -230,6 +236,50 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"http_proxy_config": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"http_proxy": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							AtLeastOneOf: []string{"http_proxy_config.0.http_proxy", "http_proxy_config.0.https_proxy"},
+						},
+
+						"https_proxy": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							AtLeastOneOf: []string{"http_proxy_config.0.http_proxy", "http_proxy_config.0.https_proxy"},
+						},
+
+						"no_proxy": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"trusted_ca": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsBase64,
+						},
+					},
+				},
+			},
+
 			"identity": {
 				Type:         pluginsdk.TypeList,
 				Optional:     true,
@@ -500,6 +550,93 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"pod_identity_profile": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allow_network_plugin_kubenet": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"user_assigned_identity": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"namespace": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"binding_selector": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"client_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsUUID,
+									},
+
+									"object_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsUUID,
+									},
+
+									"user_assigned_identity_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: msivalidate.UserAssignedIdentityID,
+									},
+								},
+							},
+						},
+
+						"user_assigned_identity_exception": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"namespace": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"pod_labels": {
+										Type:     pluginsdk.TypeMap,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"private_fqdn": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -703,6 +840,71 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"maintenance_window": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allowed": {
+							Type:         pluginsdk.TypeSet,
+							Optional:     true,
+							AtLeastOneOf: []string{"maintenance_window.0.allowed", "maintenance_window.0.not_allowed"},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"day": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(containerservice.WeekDaySunday),
+											string(containerservice.WeekDayMonday),
+											string(containerservice.WeekDayTuesday),
+											string(containerservice.WeekDayWednesday),
+											string(containerservice.WeekDayThursday),
+											string(containerservice.WeekDayFriday),
+											string(containerservice.WeekDaySaturday),
+										}, false),
+									},
+
+									"hours": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeInt,
+											ValidateFunc: validation.IntBetween(0, 23),
+										},
+									},
+								},
+							},
+						},
+
+						"not_allowed": {
+							Type:         pluginsdk.TypeSet,
+							Optional:     true,
+							AtLeastOneOf: []string{"maintenance_window.0.allowed", "maintenance_window.0.not_allowed"},
+							// a DiffSuppressFunc has no effect within a Set, so the times are normalized when hashed
+							Set: resourceKubernetesClusterMaintenanceWindowNotAllowedHash,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"end": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+
+									"start": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			// Computed
 			"fqdn": {
 				Type:     pluginsdk.TypeString,
@@ -967,6 +1169,14 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		parameters.ManagedClusterProperties.DiskEncryptionSetID = utils.String(v.(string))
 	}
 
+	if v := d.Get("http_proxy_config").([]interface{}); len(v) > 0 {
+		parameters.ManagedClusterProperties.HTTPProxyConfig = expandKubernetesClusterHTTPProxyConfig(v)
+	}
+
+	if v := d.Get("pod_identity_profile").([]interface{}); len(v) > 0 {
+		parameters.ManagedClusterProperties.PodIdentityProfile = expandKubernetesClusterPodIdentityProfile(v)
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
 	if err != nil {
 		return fmt.Errorf("creating Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
@@ -987,6 +1197,16 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 
 	d.SetId(*read.ID)
 
+	if v := d.Get("maintenance_window").([]interface{}); len(v) > 0 {
+		maintenanceConfigurationsClient := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
+		parameters := containerservice.MaintenanceConfiguration{
+			MaintenanceConfigurationProperties: expandKubernetesClusterMaintenanceConfiguration(v),
+		}
+		if _, err := maintenanceConfigurationsClient.CreateOrUpdate(ctx, resGroup, name, kubernetesClusterMaintenanceConfigurationName, parameters); err != nil {
+			return fmt.Errorf("creating Maintenance Configuration for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
 	return resourceKubernetesClusterRead(d, meta)
 }
 
@@ -1241,6 +1461,12 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		existing.Sku.Tier = containerservice.ManagedClusterSKUTier(d.Get("sku_tier").(string))
 	}
 
+	if d.HasChange("pod_identity_profile") {
+		updateCluster = true
+		podIdentityProfileRaw := d.Get("pod_identity_profile").([]interface{})
+		existing.ManagedClusterProperties.PodIdentityProfile = expandKubernetesClusterPodIdentityProfile(podIdentityProfileRaw)
+	}
+
 	if d.HasChange("automatic_channel_upgrade") {
 		updateCluster = true
 		if existing.ManagedClusterProperties.AutoUpgradeProfile == nil {
@@ -1294,6 +1520,22 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		log.Printf("[DEBUG] Upgraded the version of Kubernetes to %q..", kubernetesVersion)
 	}
 
+	if d.HasChange("maintenance_window") {
+		maintenanceConfigurationsClient := containersClient.MaintenanceConfigurationsClient
+		if v := d.Get("maintenance_window").([]interface{}); len(v) > 0 {
+			parameters := containerservice.MaintenanceConfiguration{
+				MaintenanceConfigurationProperties: expandKubernetesClusterMaintenanceConfiguration(v),
+			}
+			if _, err := maintenanceConfigurationsClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, kubernetesClusterMaintenanceConfigurationName, parameters); err != nil {
+				return fmt.Errorf("updating Maintenance Configuration for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+			}
+		} else {
+			if _, err := maintenanceConfigurationsClient.Delete(ctx, id.ResourceGroup, id.ManagedClusterName, kubernetesClusterMaintenanceConfigurationName); err != nil {
+				return fmt.Errorf("deleting Maintenance Configuration for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+			}
+		}
+	}
+
 	// update the node pool using the separate API
 	if d.HasChange("default_node_pool") {
 		log.Printf("[DEBUG] Updating of Default Node Pool..")
@@ -1416,6 +1658,11 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `default_node_pool`: %+v", err)
 		}
 
+		httpProxyConfig := flattenKubernetesClusterHTTPProxyConfig(props.HTTPProxyConfig, d)
+		if err := d.Set("http_proxy_config", httpProxyConfig); err != nil {
+			return fmt.Errorf("setting `http_proxy_config`: %+v", err)
+		}
+
 		kubeletIdentity, err := flattenKubernetesClusterIdentityProfile(props.IdentityProfile)
 		if err != nil {
 			return err
@@ -1434,6 +1681,14 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `network_profile`: %+v", err)
 		}
 
+		podIdentityProfile, err := flattenKubernetesClusterPodIdentityProfile(props.PodIdentityProfile)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("pod_identity_profile", podIdentityProfile); err != nil {
+			return fmt.Errorf("setting `pod_identity_profile`: %+v", err)
+		}
+
 		roleBasedAccessControl := flattenKubernetesClusterRoleBasedAccessControl(props, d)
 		if err := d.Set("role_based_access_control", roleBasedAccessControl); err != nil {
 			return fmt.Errorf("setting `role_based_access_control`: %+v", err)
@@ -1482,6 +1737,15 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("setting `kube_config`: %+v", err)
 	}
 
+	maintenanceConfigurationsClient := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
+	maintenanceConfiguration, err := maintenanceConfigurationsClient.Get(ctx, id.ResourceGroup, id.ManagedClusterName, kubernetesClusterMaintenanceConfigurationName)
+	if err != nil && !utils.ResponseWasNotFound(maintenanceConfiguration.Response) {
+		return fmt.Errorf("retrieving Maintenance Configuration for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+	}
+	if err := d.Set("maintenance_window", flattenKubernetesClusterMaintenanceConfiguration(maintenanceConfiguration.MaintenanceConfigurationProperties)); err != nil {
+		return fmt.Errorf("setting `maintenance_window`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -2335,3 +2599,330 @@ func expandKubernetesClusterAutoScalerProfile(input []interface{}) *containerser
 		SkipNodesWithSystemPods:       utils.String(strconv.FormatBool(skipNodesWithSystemPods)),
 	}
 }
+
+func expandKubernetesClusterMaintenanceConfiguration(input []interface{}) *containerservice.MaintenanceConfigurationProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	config := input[0].(map[string]interface{})
+
+	timeInWeek := make([]containerservice.TimeInWeek, 0)
+	for _, item := range config["allowed"].(*pluginsdk.Set).List() {
+		v := item.(map[string]interface{})
+
+		hourSlots := make([]int32, 0)
+		for _, hour := range v["hours"].(*pluginsdk.Set).List() {
+			hourSlots = append(hourSlots, int32(hour.(int)))
+		}
+
+		timeInWeek = append(timeInWeek, containerservice.TimeInWeek{
+			Day:       containerservice.WeekDay(v["day"].(string)),
+			HourSlots: &hourSlots,
+		})
+	}
+
+	notAllowedTime := make([]containerservice.TimeSpan, 0)
+	for _, item := range config["not_allowed"].(*pluginsdk.Set).List() {
+		v := item.(map[string]interface{})
+
+		// these have been validated in the schema
+		start, _ := time.Parse(time.RFC3339, v["start"].(string))
+		end, _ := time.Parse(time.RFC3339, v["end"].(string))
+
+		notAllowedTime = append(notAllowedTime, containerservice.TimeSpan{
+			Start: &date.Time{Time: start},
+			End:   &date.Time{Time: end},
+		})
+	}
+
+	return &containerservice.MaintenanceConfigurationProperties{
+		TimeInWeek:     &timeInWeek,
+		NotAllowedTime: &notAllowedTime,
+	}
+}
+
+func flattenKubernetesClusterMaintenanceConfiguration(input *containerservice.MaintenanceConfigurationProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	allowed := make([]interface{}, 0)
+	if input.TimeInWeek != nil {
+		for _, item := range *input.TimeInWeek {
+			hours := make([]interface{}, 0)
+			if item.HourSlots != nil {
+				for _, hour := range *item.HourSlots {
+					hours = append(hours, int(hour))
+				}
+			}
+
+			allowed = append(allowed, map[string]interface{}{
+				"day":   string(item.Day),
+				"hours": hours,
+			})
+		}
+	}
+
+	notAllowed := make([]interface{}, 0)
+	if input.NotAllowedTime != nil {
+		for _, item := range *input.NotAllowedTime {
+			start := ""
+			if item.Start != nil {
+				start = item.Start.UTC().Format(time.RFC3339)
+			}
+
+			end := ""
+			if item.End != nil {
+				end = item.End.UTC().Format(time.RFC3339)
+			}
+
+			notAllowed = append(notAllowed, map[string]interface{}{
+				"end":   end,
+				"start": start,
+			})
+		}
+	}
+
+	if len(allowed) == 0 && len(notAllowed) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allowed":     allowed,
+			"not_allowed": notAllowed,
+		},
+	}
+}
+
+func resourceKubernetesClusterMaintenanceWindowNotAllowedHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", normalizeKubernetesClusterMaintenanceWindowTime(m["end"].(string))))
+		buf.WriteString(fmt.Sprintf("%s-", normalizeKubernetesClusterMaintenanceWindowTime(m["start"].(string))))
+	}
+
+	return pluginsdk.HashString(buf.String())
+}
+
+// normalizeKubernetesClusterMaintenanceWindowTime returns the RFC3339 time in UTC, such that equivalent
+// times specified using a different offset (e.g. `2021-11-26T04:00:00+01:00`) are treated as the same
+func normalizeKubernetesClusterMaintenanceWindowTime(input string) string {
+	t, err := time.Parse(time.RFC3339, input)
+	if err != nil {
+		return input
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func expandKubernetesClusterHTTPProxyConfig(input []interface{}) *containerservice.ManagedClusterHTTPProxyConfig {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	config := containerservice.ManagedClusterHTTPProxyConfig{
+		NoProxy: utils.ExpandStringSlice(raw["no_proxy"].(*pluginsdk.Set).List()),
+	}
+
+	if v := raw["http_proxy"].(string); v != "" {
+		config.HTTPProxy = utils.String(v)
+	}
+
+	if v := raw["https_proxy"].(string); v != "" {
+		config.HTTPSProxy = utils.String(v)
+	}
+
+	if v := raw["trusted_ca"].(string); v != "" {
+		config.TrustedCa = utils.String(v)
+	}
+
+	return &config
+}
+
+func flattenKubernetesClusterHTTPProxyConfig(input *containerservice.ManagedClusterHTTPProxyConfig, d *pluginsdk.ResourceData) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	httpProxy := ""
+	if input.HTTPProxy != nil {
+		httpProxy = *input.HTTPProxy
+	}
+
+	httpsProxy := ""
+	if input.HTTPSProxy != nil {
+		httpsProxy = *input.HTTPSProxy
+	}
+
+	// AKS appends the addresses used within the Cluster to `no_proxy` - so only the entries which
+	// have been specified in the configuration are retained (including when none are specified),
+	// since a diff here would otherwise recreate the Cluster
+	noProxy := make([]interface{}, 0)
+	if input.NoProxy != nil {
+		configured := d.Get("http_proxy_config.0.no_proxy").(*pluginsdk.Set)
+		for _, v := range *input.NoProxy {
+			if !configured.Contains(v) {
+				continue
+			}
+
+			noProxy = append(noProxy, v)
+		}
+	}
+
+	// the trusted CA may not be returned from the API, in which case we look this up from the config
+	trustedCa := d.Get("http_proxy_config.0.trusted_ca").(string)
+	if input.TrustedCa != nil {
+		trustedCa = *input.TrustedCa
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"http_proxy":  httpProxy,
+			"https_proxy": httpsProxy,
+			"no_proxy":    noProxy,
+			"trusted_ca":  trustedCa,
+		},
+	}
+}
+
+func expandKubernetesClusterPodIdentityProfile(input []interface{}) *containerservice.ManagedClusterPodIdentityProfile {
+	if len(input) == 0 || input[0] == nil {
+		return &containerservice.ManagedClusterPodIdentityProfile{
+			Enabled: utils.Bool(false),
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	identities := make([]containerservice.ManagedClusterPodIdentity, 0)
+	for _, v := range raw["user_assigned_identity"].([]interface{}) {
+		identity := v.(map[string]interface{})
+
+		podIdentity := containerservice.ManagedClusterPodIdentity{
+			Name:      utils.String(identity["name"].(string)),
+			Namespace: utils.String(identity["namespace"].(string)),
+			Identity: &containerservice.UserAssignedIdentity{
+				ResourceID: utils.String(identity["user_assigned_identity_id"].(string)),
+				ClientID:   utils.String(identity["client_id"].(string)),
+				ObjectID:   utils.String(identity["object_id"].(string)),
+			},
+		}
+		if bindingSelector := identity["binding_selector"].(string); bindingSelector != "" {
+			podIdentity.BindingSelector = utils.String(bindingSelector)
+		}
+
+		identities = append(identities, podIdentity)
+	}
+
+	exceptions := make([]containerservice.ManagedClusterPodIdentityException, 0)
+	for _, v := range raw["user_assigned_identity_exception"].([]interface{}) {
+		exception := v.(map[string]interface{})
+
+		exceptions = append(exceptions, containerservice.ManagedClusterPodIdentityException{
+			Name:      utils.String(exception["name"].(string)),
+			Namespace: utils.String(exception["namespace"].(string)),
+			PodLabels: utils.ExpandMapStringPtrString(exception["pod_labels"].(map[string]interface{})),
+		})
+	}
+
+	return &containerservice.ManagedClusterPodIdentityProfile{
+		Enabled:                        utils.Bool(true),
+		AllowNetworkPluginKubenet:      utils.Bool(raw["allow_network_plugin_kubenet"].(bool)),
+		UserAssignedIdentities:         &identities,
+		UserAssignedIdentityExceptions: &exceptions,
+	}
+}
+
+func flattenKubernetesClusterPodIdentityProfile(input *containerservice.ManagedClusterPodIdentityProfile) ([]interface{}, error) {
+	if input == nil || input.Enabled == nil || !*input.Enabled {
+		return []interface{}{}, nil
+	}
+
+	allowNetworkPluginKubenet := false
+	if input.AllowNetworkPluginKubenet != nil {
+		allowNetworkPluginKubenet = *input.AllowNetworkPluginKubenet
+	}
+
+	identities := make([]interface{}, 0)
+	if input.UserAssignedIdentities != nil {
+		for _, v := range *input.UserAssignedIdentities {
+			name := ""
+			if v.Name != nil {
+				name = *v.Name
+			}
+
+			namespace := ""
+			if v.Namespace != nil {
+				namespace = *v.Namespace
+			}
+
+			bindingSelector := ""
+			if v.BindingSelector != nil {
+				bindingSelector = *v.BindingSelector
+			}
+
+			clientId := ""
+			objectId := ""
+			userAssignedIdentityId := ""
+			if identity := v.Identity; identity != nil {
+				if identity.ClientID != nil {
+					clientId = *identity.ClientID
+				}
+
+				if identity.ObjectID != nil {
+					objectId = *identity.ObjectID
+				}
+
+				if identity.ResourceID != nil {
+					parsedId, err := msiparse.UserAssignedIdentityID(*identity.ResourceID)
+					if err != nil {
+						return nil, err
+					}
+
+					userAssignedIdentityId = parsedId.ID()
+				}
+			}
+
+			identities = append(identities, map[string]interface{}{
+				"binding_selector":          bindingSelector,
+				"client_id":                 clientId,
+				"name":                      name,
+				"namespace":                 namespace,
+				"object_id":                 objectId,
+				"user_assigned_identity_id": userAssignedIdentityId,
+			})
+		}
+	}
+
+	exceptions := make([]interface{}, 0)
+	if input.UserAssignedIdentityExceptions != nil {
+		for _, v := range *input.UserAssignedIdentityExceptions {
+			name := ""
+			if v.Name != nil {
+				name = *v.Name
+			}
+
+			namespace := ""
+			if v.Namespace != nil {
+				namespace = *v.Namespace
+			}
+
+			exceptions = append(exceptions, map[string]interface{}{
+				"name":       name,
+				"namespace":  namespace,
+				"pod_labels": utils.FlattenMapStringPtrString(v.PodLabels),
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allow_network_plugin_kubenet":     allowNetworkPluginKubenet,
+			"user_assigned_identity":           identities,
+			"user_assigned_identity_exception": exceptions,
+		},
+	}, nil
+}
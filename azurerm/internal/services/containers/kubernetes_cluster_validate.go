@@ -118,6 +118,41 @@ func validateKubernetesCluster(d *pluginsdk.ResourceData, cluster *containerserv
 	return nil
 }
 
+// validateNodePoolPodSubnetID confirms that the Node Pool being created uses a Pod Subnet only when the other
+// Node Pools within the Kubernetes Cluster do, since Dynamic IP Allocation must be used by either all Node
+// Pools or none of them
+func validateNodePoolPodSubnetID(cluster containerservice.ManagedCluster, nodePoolName string, podSubnetID string) error {
+	props := cluster.ManagedClusterProperties
+	if props == nil || props.AgentPoolProfiles == nil {
+		return nil
+	}
+
+	usingPodSubnet := podSubnetID != ""
+	for _, pool := range *props.AgentPoolProfiles {
+		if pool.Name != nil && strings.EqualFold(*pool.Name, nodePoolName) {
+			continue
+		}
+
+		poolUsesPodSubnet := pool.PodSubnetID != nil && *pool.PodSubnetID != ""
+		if poolUsesPodSubnet == usingPodSubnet {
+			continue
+		}
+
+		existingPoolName := ""
+		if pool.Name != nil {
+			existingPoolName = *pool.Name
+		}
+
+		if usingPodSubnet {
+			return fmt.Errorf("`pod_subnet_id` cannot be specified for Node Pool %q since the existing Node Pool %q doesn't use a Pod Subnet - either all Node Pools must use a Pod Subnet or none of them", nodePoolName, existingPoolName)
+		}
+
+		return fmt.Errorf("`pod_subnet_id` must be specified for Node Pool %q since the existing Node Pool %q uses a Pod Subnet - either all Node Pools must use a Pod Subnet or none of them", nodePoolName, existingPoolName)
+	}
+
+	return nil
+}
+
 var existingClusterCommonErr = `
 Azure Kubernetes Service has recently made several breaking changes to Cluster Authentication as
 the Managed Identity Preview has concluded and entered General Availability.
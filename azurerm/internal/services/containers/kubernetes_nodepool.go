@@ -155,12 +155,32 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 					}, false),
 				},
 
+				"os_sku": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ForceNew: true,
+					Computed: true, // defaults to Ubuntu if using Linux
+					ValidateFunc: validation.StringInSlice([]string{
+						string(containerservice.OSSKUUbuntu),
+						string(containerservice.OSSKUCBLMariner),
+					}, false),
+				},
+
 				"vnet_subnet_id": {
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
 					ForceNew:     true,
 					ValidateFunc: azure.ValidateResourceID,
 				},
+
+				"pod_subnet_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: azure.ValidateResourceID,
+					RequiredWith: []string{"default_node_pool.0.vnet_subnet_id"},
+				},
+
 				"orchestrator_version": {
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
@@ -531,6 +551,251 @@ func schemaNodePoolSysctlConfig() *pluginsdk.Schema {
 	}
 }
 
+func schemaNodePoolKubeletConfigForDataSource() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"cpu_manager_policy": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"cpu_cfs_quota_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"cpu_cfs_quota_period": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"image_gc_high_threshold": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"image_gc_low_threshold": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"topology_manager_policy": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"allowed_unsafe_sysctls": {
+					Type:     pluginsdk.TypeSet,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"container_log_max_size_mb": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"container_log_max_line": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"pod_max_pid": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func schemaNodePoolLinuxOSConfigForDataSource() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"sysctl_config": schemaNodePoolSysctlConfigForDataSource(),
+
+				"transparent_huge_page_enabled": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"transparent_huge_page_defrag": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"swap_file_size_mb": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func schemaNodePoolSysctlConfigForDataSource() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"fs_aio_max_nr": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"fs_file_max": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"fs_inotify_max_user_watches": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"fs_nr_open": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"kernel_threads_max": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_core_netdev_max_backlog": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_core_optmem_max": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_core_rmem_default": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_core_rmem_max": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_core_somaxconn": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_core_wmem_default": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_core_wmem_max": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_ipv4_ip_local_port_range_min": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_ipv4_ip_local_port_range_max": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_ipv4_neigh_default_gc_thresh1": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_ipv4_neigh_default_gc_thresh2": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_ipv4_neigh_default_gc_thresh3": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_ipv4_tcp_fin_timeout": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_ipv4_tcp_keepalive_intvl": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_ipv4_tcp_keepalive_probes": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_ipv4_tcp_keepalive_time": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_ipv4_tcp_max_syn_backlog": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_ipv4_tcp_max_tw_buckets": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_ipv4_tcp_tw_reuse": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"net_netfilter_nf_conntrack_buckets": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"net_netfilter_nf_conntrack_max": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"vm_max_map_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"vm_swappiness": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"vm_vfs_cache_pressure": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
 func ConvertDefaultNodePoolToAgentPool(input *[]containerservice.ManagedClusterAgentPoolProfile) containerservice.AgentPool {
 	defaultCluster := (*input)[0]
 	return containerservice.AgentPool{
@@ -540,7 +805,9 @@ func ConvertDefaultNodePoolToAgentPool(input *[]containerservice.ManagedClusterA
 			VMSize:                    defaultCluster.VMSize,
 			OsDiskSizeGB:              defaultCluster.OsDiskSizeGB,
 			OsDiskType:                defaultCluster.OsDiskType,
+			OsSKU:                     defaultCluster.OsSKU,
 			VnetSubnetID:              defaultCluster.VnetSubnetID,
+			PodSubnetID:               defaultCluster.PodSubnetID,
 			KubeletConfig:             defaultCluster.KubeletConfig,
 			LinuxOSConfig:             defaultCluster.LinuxOSConfig,
 			MaxPods:                   defaultCluster.MaxPods,
@@ -640,10 +907,18 @@ func ExpandDefaultNodePool(d *pluginsdk.ResourceData) (*[]containerservice.Manag
 		profile.OsDiskType = containerservice.OSDiskType(raw["os_disk_type"].(string))
 	}
 
+	if osSku := raw["os_sku"].(string); osSku != "" {
+		profile.OsSKU = containerservice.OSSKU(osSku)
+	}
+
 	if vnetSubnetID := raw["vnet_subnet_id"].(string); vnetSubnetID != "" {
 		profile.VnetSubnetID = utils.String(vnetSubnetID)
 	}
 
+	if podSubnetID := raw["pod_subnet_id"].(string); podSubnetID != "" {
+		profile.PodSubnetID = utils.String(podSubnetID)
+	}
+
 	if orchestratorVersion := raw["orchestrator_version"].(string); orchestratorVersion != "" {
 		profile.OrchestratorVersion = utils.String(orchestratorVersion)
 	}
@@ -974,6 +1249,11 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 		vnetSubnetId = *agentPool.VnetSubnetID
 	}
 
+	podSubnetId := ""
+	if agentPool.PodSubnetID != nil {
+		podSubnetId = *agentPool.PodSubnetID
+	}
+
 	orchestratorVersion := ""
 	if agentPool.OrchestratorVersion != nil {
 		orchestratorVersion = *agentPool.OrchestratorVersion
@@ -1010,6 +1290,7 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 			"node_taints":                  []string{},
 			"os_disk_size_gb":              osDiskSizeGB,
 			"os_disk_type":                 string(osDiskType),
+			"os_sku":                       string(agentPool.OsSKU),
 			"tags":                         tags.Flatten(agentPool.Tags),
 			"type":                         string(agentPool.Type),
 			"vm_size":                      vmSize,
@@ -1017,6 +1298,7 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 			"proximity_placement_group_id": proximityPlacementGroupId,
 			"upgrade_settings":             upgradeSettings,
 			"vnet_subnet_id":               vnetSubnetId,
+			"pod_subnet_id":                podSubnetId,
 			"only_critical_addons_enabled": criticalAddonsEnabled,
 			"kubelet_config":               flattenAgentPoolKubeletConfig(agentPool.KubeletConfig),
 			"linux_os_config":              linuxOSConfig,
@@ -3,23 +3,37 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-01-15/documentdb"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/sdk/cassandraclusters"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/sdk/cassandradatacenters"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/sdk/sqlroleassignments"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/sdk/sqlroledefinitions"
 )
 
 type Client struct {
-	CassandraClient         *documentdb.CassandraResourcesClient
-	DatabaseClient          *documentdb.DatabaseAccountsClient
-	GremlinClient           *documentdb.GremlinResourcesClient
-	MongoDbClient           *documentdb.MongoDBResourcesClient
-	NotebookWorkspaceClient *documentdb.NotebookWorkspacesClient
-	SqlClient               *documentdb.SQLResourcesClient
-	SqlResourceClient       *documentdb.SQLResourcesClient
-	TableClient             *documentdb.TableResourcesClient
+	CassandraClient            *documentdb.CassandraResourcesClient
+	CassandraClustersClient    *cassandraclusters.CassandraClustersClient
+	CassandraDatacentersClient *cassandradatacenters.CassandraDataCentersClient
+	DatabaseClient             *documentdb.DatabaseAccountsClient
+	GremlinClient              *documentdb.GremlinResourcesClient
+	MongoDbClient              *documentdb.MongoDBResourcesClient
+	NotebookWorkspaceClient    *documentdb.NotebookWorkspacesClient
+	SqlClient                  *documentdb.SQLResourcesClient
+	SqlResourceClient          *documentdb.SQLResourcesClient
+	SqlRoleAssignmentClient    *sqlroleassignments.SqlRoleAssignmentsClient
+	SqlRoleDefinitionClient    *sqlroledefinitions.SqlRoleDefinitionsClient
+	TableClient                *documentdb.TableResourcesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	cassandraClient := documentdb.NewCassandraResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&cassandraClient.Client, o.ResourceManagerAuthorizer)
 
+	cassandraClustersClient := cassandraclusters.NewCassandraClustersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&cassandraClustersClient.Client, o.ResourceManagerAuthorizer)
+
+	cassandraDatacentersClient := cassandradatacenters.NewCassandraDataCentersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&cassandraDatacentersClient.Client, o.ResourceManagerAuthorizer)
+
 	databaseClient := documentdb.NewDatabaseAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&databaseClient.Client, o.ResourceManagerAuthorizer)
 
@@ -38,17 +52,27 @@ func NewClient(o *common.ClientOptions) *Client {
 	sqlResourceClient := documentdb.NewSQLResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&sqlResourceClient.Client, o.ResourceManagerAuthorizer)
 
+	sqlRoleAssignmentClient := sqlroleassignments.NewSqlRoleAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&sqlRoleAssignmentClient.Client, o.ResourceManagerAuthorizer)
+
+	sqlRoleDefinitionClient := sqlroledefinitions.NewSqlRoleDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&sqlRoleDefinitionClient.Client, o.ResourceManagerAuthorizer)
+
 	tableClient := documentdb.NewTableResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&tableClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		CassandraClient:         &cassandraClient,
-		DatabaseClient:          &databaseClient,
-		GremlinClient:           &gremlinClient,
-		MongoDbClient:           &mongoDbClient,
-		NotebookWorkspaceClient: &notebookWorkspaceClient,
-		SqlClient:               &sqlClient,
-		SqlResourceClient:       &sqlResourceClient,
-		TableClient:             &tableClient,
+		CassandraClient:            &cassandraClient,
+		CassandraClustersClient:    &cassandraClustersClient,
+		CassandraDatacentersClient: &cassandraDatacentersClient,
+		DatabaseClient:             &databaseClient,
+		GremlinClient:              &gremlinClient,
+		MongoDbClient:              &mongoDbClient,
+		NotebookWorkspaceClient:    &notebookWorkspaceClient,
+		SqlClient:                  &sqlClient,
+		SqlResourceClient:          &sqlResourceClient,
+		SqlRoleAssignmentClient:    &sqlRoleAssignmentClient,
+		SqlRoleDefinitionClient:    &sqlRoleDefinitionClient,
+		TableClient:                &tableClient,
 	}
 }
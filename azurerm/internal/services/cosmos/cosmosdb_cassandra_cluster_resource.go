@@ -0,0 +1,385 @@
+package cosmos
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/sdk/cassandraclusters"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/validate"
+	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceCosmosDbCassandraCluster() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCosmosDbCassandraClusterCreate,
+		Read:   resourceCosmosDbCassandraClusterRead,
+		Update: resourceCosmosDbCassandraClusterUpdate,
+		Delete: resourceCosmosDbCassandraClusterDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.CassandraClusterID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CassandraClusterName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"delegated_management_subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+
+			"default_admin_password": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"authentication_method": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(cassandraclusters.AuthenticationMethodCassandra),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cassandraclusters.AuthenticationMethodNone),
+					string(cassandraclusters.AuthenticationMethodCassandra),
+				}, false),
+			},
+
+			"version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "3.11",
+				ValidateFunc: validation.StringInSlice([]string{
+					"3.11",
+					"4.0",
+				}, false),
+			},
+
+			"client_certificate_pems": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"external_gossip_certificate_pems": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"external_seed_node_ip_addresses": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+
+			"hours_between_backups": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      24,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"repair_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"seed_node_ip_addresses": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceCosmosDbCassandraClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Cosmos.CassandraClustersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewCassandraClusterID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_cosmosdb_cassandra_cluster", id.ID())
+	}
+
+	authenticationMethod := cassandraclusters.AuthenticationMethod(d.Get("authentication_method").(string))
+	parameters := cassandraclusters.ClusterResource{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Properties: &cassandraclusters.ClusterResourceProperties{
+			AuthenticationMethod:          &authenticationMethod,
+			CassandraVersion:              utils.String(d.Get("version").(string)),
+			ClientCertificates:            expandCassandraClusterCertificates(d.Get("client_certificate_pems").([]interface{})),
+			DelegatedManagementSubnetId:   utils.String(d.Get("delegated_management_subnet_id").(string)),
+			ExternalGossipCertificates:    expandCassandraClusterCertificates(d.Get("external_gossip_certificate_pems").([]interface{})),
+			ExternalSeedNodes:             expandCassandraClusterSeedNodes(d.Get("external_seed_node_ip_addresses").([]interface{})),
+			HoursBetweenBackups:           utils.Int64(int64(d.Get("hours_between_backups").(int))),
+			InitialCassandraAdminPassword: utils.String(d.Get("default_admin_password").(string)),
+			RepairEnabled:                 utils.Bool(d.Get("repair_enabled").(bool)),
+		},
+		Tags: expandCassandraClusterTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCosmosDbCassandraClusterRead(d, meta)
+}
+
+func resourceCosmosDbCassandraClusterRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.CassandraClustersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CassandraClusterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		if props := model.Properties; props != nil {
+			authenticationMethod := ""
+			if props.AuthenticationMethod != nil {
+				authenticationMethod = string(*props.AuthenticationMethod)
+			}
+			d.Set("authentication_method", authenticationMethod)
+			d.Set("delegated_management_subnet_id", props.DelegatedManagementSubnetId)
+			d.Set("version", props.CassandraVersion)
+			d.Set("repair_enabled", props.RepairEnabled)
+
+			hoursBetweenBackups := 0
+			if props.HoursBetweenBackups != nil {
+				hoursBetweenBackups = int(*props.HoursBetweenBackups)
+			}
+			d.Set("hours_between_backups", hoursBetweenBackups)
+
+			if err := d.Set("client_certificate_pems", flattenCassandraClusterCertificates(props.ClientCertificates)); err != nil {
+				return fmt.Errorf("setting `client_certificate_pems`: %+v", err)
+			}
+			if err := d.Set("external_gossip_certificate_pems", flattenCassandraClusterCertificates(props.ExternalGossipCertificates)); err != nil {
+				return fmt.Errorf("setting `external_gossip_certificate_pems`: %+v", err)
+			}
+			if err := d.Set("external_seed_node_ip_addresses", flattenCassandraClusterSeedNodes(props.ExternalSeedNodes)); err != nil {
+				return fmt.Errorf("setting `external_seed_node_ip_addresses`: %+v", err)
+			}
+			if err := d.Set("seed_node_ip_addresses", flattenCassandraClusterSeedNodes(props.SeedNodes)); err != nil {
+				return fmt.Errorf("setting `seed_node_ip_addresses`: %+v", err)
+			}
+		}
+
+		// the API doesn't return the admin password, so `default_admin_password` is left as configured
+
+		return tags.FlattenAndSet(d, flattenCassandraClusterTags(model.Tags))
+	}
+
+	return nil
+}
+
+func resourceCosmosDbCassandraClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.CassandraClustersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CassandraClusterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	props := cassandraclusters.ClusterResourceProperties{}
+
+	if d.HasChange("authentication_method") {
+		authenticationMethod := cassandraclusters.AuthenticationMethod(d.Get("authentication_method").(string))
+		props.AuthenticationMethod = &authenticationMethod
+	}
+
+	if d.HasChange("client_certificate_pems") {
+		props.ClientCertificates = expandCassandraClusterCertificates(d.Get("client_certificate_pems").([]interface{}))
+	}
+
+	if d.HasChange("external_gossip_certificate_pems") {
+		props.ExternalGossipCertificates = expandCassandraClusterCertificates(d.Get("external_gossip_certificate_pems").([]interface{}))
+	}
+
+	if d.HasChange("external_seed_node_ip_addresses") {
+		props.ExternalSeedNodes = expandCassandraClusterSeedNodes(d.Get("external_seed_node_ip_addresses").([]interface{}))
+	}
+
+	if d.HasChange("hours_between_backups") {
+		props.HoursBetweenBackups = utils.Int64(int64(d.Get("hours_between_backups").(int)))
+	}
+
+	if d.HasChange("repair_enabled") {
+		props.RepairEnabled = utils.Bool(d.Get("repair_enabled").(bool))
+	}
+
+	parameters := cassandraclusters.ClusterResource{
+		Properties: &props,
+	}
+
+	if d.HasChange("tags") {
+		parameters.Tags = expandCassandraClusterTags(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceCosmosDbCassandraClusterRead(d, meta)
+}
+
+func resourceCosmosDbCassandraClusterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.CassandraClustersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CassandraClusterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandCassandraClusterCertificates(input []interface{}) *[]cassandraclusters.Certificate {
+	results := make([]cassandraclusters.Certificate, 0)
+
+	for _, pem := range input {
+		results = append(results, cassandraclusters.Certificate{
+			Pem: utils.String(pem.(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenCassandraClusterCertificates(input *[]cassandraclusters.Certificate) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.Pem != nil {
+			results = append(results, *item.Pem)
+		}
+	}
+
+	return results
+}
+
+func expandCassandraClusterSeedNodes(input []interface{}) *[]cassandraclusters.SeedNode {
+	results := make([]cassandraclusters.SeedNode, 0)
+
+	for _, ipAddress := range input {
+		results = append(results, cassandraclusters.SeedNode{
+			IpAddress: utils.String(ipAddress.(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenCassandraClusterSeedNodes(input *[]cassandraclusters.SeedNode) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.IpAddress != nil {
+			results = append(results, *item.IpAddress)
+		}
+	}
+
+	return results
+}
+
+func expandCassandraClusterTags(input map[string]interface{}) *map[string]string {
+	output := tags.ToTypedObject(tags.Expand(input))
+	return &output
+}
+
+func flattenCassandraClusterTags(input *map[string]string) map[string]*string {
+	if input == nil {
+		return map[string]*string{}
+	}
+
+	return tags.FromTypedObject(*input)
+}
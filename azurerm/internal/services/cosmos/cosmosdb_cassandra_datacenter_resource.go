@@ -0,0 +1,252 @@
+package cosmos
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/sdk/cassandradatacenters"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/validate"
+	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceCosmosDbCassandraDatacenter() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCosmosDbCassandraDatacenterCreate,
+		Read:   resourceCosmosDbCassandraDatacenterRead,
+		Update: resourceCosmosDbCassandraDatacenterUpdate,
+		Delete: resourceCosmosDbCassandraDatacenterDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.CassandraDatacenterID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cassandra_cluster_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CassandraClusterID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"delegated_management_subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+
+			"node_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntAtLeast(3),
+			},
+
+			"sku_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "Standard_DS14_v2",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"availability_zones_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"base64_encoded_yaml_fragment": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsBase64,
+			},
+
+			"seed_node_ip_addresses": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceCosmosDbCassandraDatacenterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.CassandraDatacentersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	clusterId, err := parse.CassandraClusterID(d.Get("cassandra_cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewCassandraDatacenterID(clusterId.SubscriptionId, clusterId.ResourceGroup, clusterId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_cosmosdb_cassandra_datacenter", id.ID())
+	}
+
+	parameters := cassandradatacenters.DataCenterResource{
+		Properties: &cassandradatacenters.DataCenterResourceProperties{
+			AvailabilityZone:   utils.Bool(d.Get("availability_zones_enabled").(bool)),
+			DataCenterLocation: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+			DelegatedSubnetId:  utils.String(d.Get("delegated_management_subnet_id").(string)),
+			NodeCount:          utils.Int64(int64(d.Get("node_count").(int))),
+			Sku:                utils.String(d.Get("sku_name").(string)),
+		},
+	}
+
+	if v := d.Get("base64_encoded_yaml_fragment").(string); v != "" {
+		parameters.Properties.Base64EncodedCassandraYamlFragment = utils.String(v)
+	}
+
+	if err := client.CreateUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCosmosDbCassandraDatacenterRead(d, meta)
+}
+
+func resourceCosmosDbCassandraDatacenterRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.CassandraDatacentersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CassandraDatacenterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DataCenterName)
+	d.Set("cassandra_cluster_id", parse.NewCassandraClusterID(id.SubscriptionId, id.ResourceGroup, id.CassandraClusterName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("location", location.NormalizeNilable(props.DataCenterLocation))
+			d.Set("delegated_management_subnet_id", props.DelegatedSubnetId)
+			d.Set("sku_name", props.Sku)
+			d.Set("availability_zones_enabled", props.AvailabilityZone)
+			d.Set("base64_encoded_yaml_fragment", props.Base64EncodedCassandraYamlFragment)
+
+			nodeCount := 0
+			if props.NodeCount != nil {
+				nodeCount = int(*props.NodeCount)
+			}
+			d.Set("node_count", nodeCount)
+
+			seedNodes := make([]interface{}, 0)
+			if props.SeedNodes != nil {
+				for _, item := range *props.SeedNodes {
+					if item.IpAddress != nil {
+						seedNodes = append(seedNodes, *item.IpAddress)
+					}
+				}
+			}
+			if err := d.Set("seed_node_ip_addresses", seedNodes); err != nil {
+				return fmt.Errorf("setting `seed_node_ip_addresses`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceCosmosDbCassandraDatacenterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.CassandraDatacentersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CassandraDatacenterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	props := cassandradatacenters.DataCenterResourceProperties{}
+
+	if d.HasChange("node_count") {
+		props.NodeCount = utils.Int64(int64(d.Get("node_count").(int)))
+	}
+
+	if d.HasChange("sku_name") {
+		props.Sku = utils.String(d.Get("sku_name").(string))
+	}
+
+	if d.HasChange("base64_encoded_yaml_fragment") {
+		props.Base64EncodedCassandraYamlFragment = utils.String(d.Get("base64_encoded_yaml_fragment").(string))
+	}
+
+	parameters := cassandradatacenters.DataCenterResource{
+		Properties: &props,
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceCosmosDbCassandraDatacenterRead(d, meta)
+}
+
+func resourceCosmosDbCassandraDatacenterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.CassandraDatacentersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CassandraDatacenterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package cassandraclusters
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CassandraClustersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCassandraClustersClientWithBaseURI(endpoint string) CassandraClustersClient {
+	return CassandraClustersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
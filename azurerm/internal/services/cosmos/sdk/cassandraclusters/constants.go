@@ -0,0 +1,19 @@
+package cassandraclusters
+
+type AuthenticationMethod string
+
+const (
+	AuthenticationMethodCassandra AuthenticationMethod = "Cassandra"
+	AuthenticationMethodNone      AuthenticationMethod = "None"
+)
+
+type ManagedCassandraProvisioningState string
+
+const (
+	ManagedCassandraProvisioningStateCanceled  ManagedCassandraProvisioningState = "Canceled"
+	ManagedCassandraProvisioningStateCreating  ManagedCassandraProvisioningState = "Creating"
+	ManagedCassandraProvisioningStateDeleting  ManagedCassandraProvisioningState = "Deleting"
+	ManagedCassandraProvisioningStateFailed    ManagedCassandraProvisioningState = "Failed"
+	ManagedCassandraProvisioningStateSucceeded ManagedCassandraProvisioningState = "Succeeded"
+	ManagedCassandraProvisioningStateUpdating  ManagedCassandraProvisioningState = "Updating"
+)
@@ -0,0 +1,76 @@
+package cassandraclusters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type CreateUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CreateUpdate ...
+func (c CassandraClustersClient) CreateUpdate(ctx context.Context, id resourceids.Id, input ClusterResource) (result CreateUpdateResponse, err error) {
+	req, err := c.preparerForCreateUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cassandraclusters.CassandraClustersClient", "CreateUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForCreateUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cassandraclusters.CassandraClustersClient", "CreateUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// CreateUpdateThenPoll performs CreateUpdate then polls until it's completed
+func (c CassandraClustersClient) CreateUpdateThenPoll(ctx context.Context, id resourceids.Id, input ClusterResource) error {
+	result, err := c.CreateUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForCreateUpdate prepares the CreateUpdate request.
+func (c CassandraClustersClient) preparerForCreateUpdate(ctx context.Context, id resourceids.Id, input ClusterResource) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForCreateUpdate sends the CreateUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c CassandraClustersClient) senderForCreateUpdate(ctx context.Context, req *http.Request) (future CreateUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
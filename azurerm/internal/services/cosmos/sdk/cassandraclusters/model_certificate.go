@@ -0,0 +1,5 @@
+package cassandraclusters
+
+type Certificate struct {
+	Pem *string `json:"pem,omitempty"`
+}
@@ -0,0 +1,18 @@
+package cassandraclusters
+
+type ClusterResourceProperties struct {
+	AuthenticationMethod          *AuthenticationMethod              `json:"authenticationMethod,omitempty"`
+	CassandraVersion              *string                            `json:"cassandraVersion,omitempty"`
+	ClientCertificates            *[]Certificate                     `json:"clientCertificates,omitempty"`
+	ClusterNameOverride           *string                            `json:"clusterNameOverride,omitempty"`
+	DelegatedManagementSubnetId   *string                            `json:"delegatedManagementSubnetId,omitempty"`
+	ExternalGossipCertificates    *[]Certificate                     `json:"externalGossipCertificates,omitempty"`
+	ExternalSeedNodes             *[]SeedNode                        `json:"externalSeedNodes,omitempty"`
+	GossipCertificates            *[]Certificate                     `json:"gossipCertificates,omitempty"`
+	HoursBetweenBackups           *int64                             `json:"hoursBetweenBackups,omitempty"`
+	InitialCassandraAdminPassword *string                            `json:"initialCassandraAdminPassword,omitempty"`
+	ProvisioningState             *ManagedCassandraProvisioningState `json:"provisioningState,omitempty"`
+	RepairEnabled                 *bool                              `json:"repairEnabled,omitempty"`
+	RestoreFromBackupId           *string                            `json:"restoreFromBackupId,omitempty"`
+	SeedNodes                     *[]SeedNode                        `json:"seedNodes,omitempty"`
+}
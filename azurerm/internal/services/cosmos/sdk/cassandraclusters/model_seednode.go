@@ -0,0 +1,5 @@
+package cassandraclusters
+
+type SeedNode struct {
+	IpAddress *string `json:"ipAddress,omitempty"`
+}
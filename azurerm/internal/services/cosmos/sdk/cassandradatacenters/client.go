@@ -0,0 +1,15 @@
+package cassandradatacenters
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CassandraDataCentersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCassandraDataCentersClientWithBaseURI(endpoint string) CassandraDataCentersClient {
+	return CassandraDataCentersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
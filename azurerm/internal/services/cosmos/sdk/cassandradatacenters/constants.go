@@ -0,0 +1,12 @@
+package cassandradatacenters
+
+type ManagedCassandraProvisioningState string
+
+const (
+	ManagedCassandraProvisioningStateCanceled  ManagedCassandraProvisioningState = "Canceled"
+	ManagedCassandraProvisioningStateCreating  ManagedCassandraProvisioningState = "Creating"
+	ManagedCassandraProvisioningStateDeleting  ManagedCassandraProvisioningState = "Deleting"
+	ManagedCassandraProvisioningStateFailed    ManagedCassandraProvisioningState = "Failed"
+	ManagedCassandraProvisioningStateSucceeded ManagedCassandraProvisioningState = "Succeeded"
+	ManagedCassandraProvisioningStateUpdating  ManagedCassandraProvisioningState = "Updating"
+)
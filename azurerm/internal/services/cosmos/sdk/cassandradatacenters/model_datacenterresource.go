@@ -0,0 +1,8 @@
+package cassandradatacenters
+
+type DataCenterResource struct {
+	Id         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *DataCenterResourceProperties `json:"properties,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
@@ -0,0 +1,14 @@
+package cassandradatacenters
+
+type DataCenterResourceProperties struct {
+	AvailabilityZone                   *bool                              `json:"availabilityZone,omitempty"`
+	Base64EncodedCassandraYamlFragment *string                            `json:"base64EncodedCassandraYamlFragment,omitempty"`
+	DataCenterLocation                 *string                            `json:"dataCenterLocation,omitempty"`
+	DelegatedSubnetId                  *string                            `json:"delegatedSubnetId,omitempty"`
+	DiskCapacity                       *int64                             `json:"diskCapacity,omitempty"`
+	DiskSku                            *string                            `json:"diskSku,omitempty"`
+	NodeCount                          *int64                             `json:"nodeCount,omitempty"`
+	ProvisioningState                  *ManagedCassandraProvisioningState `json:"provisioningState,omitempty"`
+	SeedNodes                          *[]SeedNode                        `json:"seedNodes,omitempty"`
+	Sku                                *string                            `json:"sku,omitempty"`
+}
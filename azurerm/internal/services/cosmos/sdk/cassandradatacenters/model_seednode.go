@@ -0,0 +1,5 @@
+package cassandradatacenters
+
+type SeedNode struct {
+	IpAddress *string `json:"ipAddress,omitempty"`
+}
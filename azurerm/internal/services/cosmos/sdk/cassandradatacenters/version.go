@@ -0,0 +1,9 @@
+package cassandradatacenters
+
+import "fmt"
+
+const defaultApiVersion = "2021-04-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/cassandradatacenters/%s", defaultApiVersion)
+}
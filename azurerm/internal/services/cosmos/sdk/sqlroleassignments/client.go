@@ -0,0 +1,15 @@
+package sqlroleassignments
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SqlRoleAssignmentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSqlRoleAssignmentsClientWithBaseURI(endpoint string) SqlRoleAssignmentsClient {
+	return SqlRoleAssignmentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
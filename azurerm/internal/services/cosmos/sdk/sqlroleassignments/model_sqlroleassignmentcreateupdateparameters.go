@@ -0,0 +1,5 @@
+package sqlroleassignments
+
+type SqlRoleAssignmentCreateUpdateParameters struct {
+	Properties *SqlRoleAssignmentResource `json:"properties,omitempty"`
+}
@@ -0,0 +1,8 @@
+package sqlroleassignments
+
+type SqlRoleAssignmentGetResults struct {
+	Id         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *SqlRoleAssignmentResource `json:"properties,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+}
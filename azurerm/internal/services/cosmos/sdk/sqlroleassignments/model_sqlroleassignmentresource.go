@@ -0,0 +1,7 @@
+package sqlroleassignments
+
+type SqlRoleAssignmentResource struct {
+	PrincipalId      *string `json:"principalId,omitempty"`
+	RoleDefinitionId *string `json:"roleDefinitionId,omitempty"`
+	Scope            *string `json:"scope,omitempty"`
+}
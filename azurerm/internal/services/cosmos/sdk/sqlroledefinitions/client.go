@@ -0,0 +1,15 @@
+package sqlroledefinitions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SqlRoleDefinitionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSqlRoleDefinitionsClientWithBaseURI(endpoint string) SqlRoleDefinitionsClient {
+	return SqlRoleDefinitionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
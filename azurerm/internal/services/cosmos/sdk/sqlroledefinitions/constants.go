@@ -0,0 +1,8 @@
+package sqlroledefinitions
+
+type RoleDefinitionType string
+
+const (
+	RoleDefinitionTypeBuiltInRole RoleDefinitionType = "BuiltInRole"
+	RoleDefinitionTypeCustomRole  RoleDefinitionType = "CustomRole"
+)
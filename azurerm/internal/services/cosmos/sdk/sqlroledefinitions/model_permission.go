@@ -0,0 +1,6 @@
+package sqlroledefinitions
+
+type Permission struct {
+	DataActions    *[]string `json:"dataActions,omitempty"`
+	NotDataActions *[]string `json:"notDataActions,omitempty"`
+}
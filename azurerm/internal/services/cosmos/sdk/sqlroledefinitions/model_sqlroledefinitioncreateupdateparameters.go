@@ -0,0 +1,5 @@
+package sqlroledefinitions
+
+type SqlRoleDefinitionCreateUpdateParameters struct {
+	Properties *SqlRoleDefinitionResource `json:"properties,omitempty"`
+}
@@ -0,0 +1,8 @@
+package sqlroledefinitions
+
+type SqlRoleDefinitionGetResults struct {
+	Id         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *SqlRoleDefinitionResource `json:"properties,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package sqlroledefinitions
+
+type SqlRoleDefinitionResource struct {
+	AssignableScopes *[]string          `json:"assignableScopes,omitempty"`
+	Permissions      *[]Permission      `json:"permissions,omitempty"`
+	RoleName         *string            `json:"roleName,omitempty"`
+	Type             RoleDefinitionType `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package sqlroledefinitions
+
+import "fmt"
+
+const defaultApiVersion = "2021-04-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/sqlroledefinitions/%s", defaultApiVersion)
+}
@@ -62,3 +62,14 @@ func CosmosMaxThroughput(i interface{}, k string) (warnings []string, errors []e
 
 	return warnings, errors
 }
+
+func CassandraClusterName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// Portal: The value must contain only lowercase letters, numbers or hyphens, and must start with a letter
+	if matched := regexp.MustCompile("^[a-z][-a-z0-9]{0,43}[a-z0-9]$").Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%s name must be 2 - 45 characters long, start with a letter, end with a letter or number, and contain only lowercase letters, numbers and hyphens.", k))
+	}
+
+	return warnings, errors
+}
@@ -149,3 +149,50 @@ func TestCosmosMaxThroughput(t *testing.T) {
 		}
 	}
 }
+
+func TestCassandraClusterName(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "cluster-1",
+			Errors: 0,
+		},
+		{
+			Value:  "c1",
+			Errors: 0,
+		},
+		{
+			Value:  "c",
+			Errors: 1,
+		},
+		{
+			Value:  "1cluster",
+			Errors: 1,
+		},
+		{
+			Value:  "cluster-",
+			Errors: 1,
+		},
+		{
+			Value:  "Cluster1",
+			Errors: 1,
+		},
+		{
+			Value:  "cluster_1",
+			Errors: 1,
+		},
+		{
+			Value:  "cluster-cluster-cluster-cluster-cluster-cluste",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := CassandraClusterName(tc.Value, "name")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected CassandraClusterName to trigger '%d' errors for '%s' - got '%d'", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
+)
+
+// SqlRoleScope validates that the scope of a Cosmos DB SQL Role Assignment/Definition is either
+// a Cosmos DB Account ID, or a Database or Container path within that Account, in the form:
+// `{accountId}`, `{accountId}/dbs/{databaseName}` or `{accountId}/dbs/{databaseName}/colls/{containerName}`
+func SqlRoleScope(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	accountId := v
+	path := ""
+	if i := strings.Index(v, "/dbs/"); i != -1 {
+		accountId = v[:i]
+		path = v[i+len("/dbs/"):]
+	}
+
+	if _, err := parse.DatabaseAccountID(accountId); err != nil {
+		errors = append(errors, fmt.Errorf("%q must start with a Cosmos DB Account ID: %+v", key, err))
+		return
+	}
+
+	if strings.Contains(v, "/dbs/") && !regexp.MustCompile(`^[^/]+(/colls/[^/]+)?$`).MatchString(path) {
+		errors = append(errors, fmt.Errorf("%q must be a Cosmos DB Account ID, or a path in the form `{accountId}/dbs/{databaseName}` or `{accountId}/dbs/{databaseName}/colls/{containerName}`, got %q", key, v))
+	}
+
+	return
+}
@@ -4,19 +4,34 @@ import (
 	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/sdk/keyrotationpolicies"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/sdk/roleassignments"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/sdk/roledefinitions"
 )
 
 type Client struct {
-	ManagedHsmClient *keyvault.ManagedHsmsClient
-	ManagementClient *keyvaultmgmt.BaseClient
-	VaultsClient     *keyvault.VaultsClient
-	options          *common.ClientOptions
+	KeyRotationPoliciesClient       *keyrotationpolicies.KeyRotationPoliciesClient
+	ManagedHsmClient                *keyvault.ManagedHsmsClient
+	ManagedHsmRoleAssignmentsClient *roleassignments.RoleAssignmentsClient
+	ManagedHsmRoleDefinitionsClient *roledefinitions.RoleDefinitionsClient
+	ManagementClient                *keyvaultmgmt.BaseClient
+	VaultsClient                    *keyvault.VaultsClient
+	options                         *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	keyRotationPoliciesClient := keyrotationpolicies.NewKeyRotationPoliciesClient()
+	o.ConfigureClient(&keyRotationPoliciesClient.Client, o.KeyVaultAuthorizer)
+
 	managedHsmClient := keyvault.NewManagedHsmsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedHsmClient.Client, o.ResourceManagerAuthorizer)
 
+	managedHsmRoleAssignmentsClient := roleassignments.NewRoleAssignmentsClient()
+	o.ConfigureClient(&managedHsmRoleAssignmentsClient.Client, o.KeyVaultAuthorizer)
+
+	managedHsmRoleDefinitionsClient := roledefinitions.NewRoleDefinitionsClient()
+	o.ConfigureClient(&managedHsmRoleDefinitionsClient.Client, o.KeyVaultAuthorizer)
+
 	managementClient := keyvaultmgmt.New()
 	o.ConfigureClient(&managementClient.Client, o.KeyVaultAuthorizer)
 
@@ -24,10 +39,13 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ManagedHsmClient: &managedHsmClient,
-		ManagementClient: &managementClient,
-		VaultsClient:     &vaultsClient,
-		options:          o,
+		KeyRotationPoliciesClient:       &keyRotationPoliciesClient,
+		ManagedHsmClient:                &managedHsmClient,
+		ManagedHsmRoleAssignmentsClient: &managedHsmRoleAssignmentsClient,
+		ManagedHsmRoleDefinitionsClient: &managedHsmRoleDefinitionsClient,
+		ManagementClient:                &managementClient,
+		VaultsClient:                    &vaultsClient,
+		options:                         o,
 	}
 }
 
@@ -2,8 +2,10 @@ package keyvault
 
 import (
 	"fmt"
+	"log"
 	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
@@ -72,6 +74,42 @@ func dataSourceKeyVaultKey() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"rotation_policy": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expire_after": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"automatic": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"time_after_creation": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"time_before_expiry": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"notify_before_expiry": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": tags.SchemaDataSource(),
 		},
 	}
@@ -127,6 +165,18 @@ func dataSourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	d.Set("version", parsedId.Version)
 
+	rotationPolicy, err := keyVaultsClient.KeyRotationPoliciesClient.GetKeyRotationPolicy(ctx, *keyVaultBaseUri, name)
+	if err != nil {
+		// the `getrotationpolicy` permission isn't granted by older access policies
+		if !utils.ResponseWasForbidden(autorest.Response{Response: rotationPolicy.HttpResponse}) {
+			return fmt.Errorf("retrieving the Rotation Policy for Key %q (Key Vault %q): %+v", name, *keyVaultBaseUri, err)
+		}
+		log.Printf("[DEBUG] Unable to retrieve the Rotation Policy for Key %q (Key Vault %q) - skipping", name, *keyVaultBaseUri)
+	}
+	if err := d.Set("rotation_policy", flattenKeyVaultKeyRotationPolicy(rotationPolicy.Model, true)); err != nil {
+		return fmt.Errorf("setting `rotation_policy`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
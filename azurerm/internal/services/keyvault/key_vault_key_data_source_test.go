@@ -27,6 +27,22 @@ func TestAccDataSourceKeyVaultKey_complete(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceKeyVaultKey_rotationPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_key_vault_key", "test")
+	r := KeyVaultKeyDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.rotationPolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("rotation_policy.0.expire_after").HasValue("P90D"),
+				check.That(data.ResourceName).Key("rotation_policy.0.automatic.0.time_after_creation").HasValue("P60D"),
+				check.That(data.ResourceName).Key("rotation_policy.0.notify_before_expiry").HasValue("P29D"),
+			),
+		},
+	})
+}
+
 func (KeyVaultKeyDataSource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -37,3 +53,14 @@ data "azurerm_key_vault_key" "test" {
 }
 `, KeyVaultKeyResource{}.complete(data))
 }
+
+func (KeyVaultKeyDataSource) rotationPolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_key" "test" {
+  name         = azurerm_key_vault_key.test.name
+  key_vault_id = azurerm_key_vault.test.id
+}
+`, KeyVaultKeyResource{}.rotationPolicy(data, "P90D", "time_after_creation = \"P60D\""))
+}
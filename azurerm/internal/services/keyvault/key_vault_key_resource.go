@@ -5,14 +5,18 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/rickb777/date/period"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	commonValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/sdk/keyrotationpolicies"
 	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
@@ -29,6 +33,8 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 		Delete:   resourceKeyVaultKeyDelete,
 		Importer: pluginsdk.DefaultImporter(),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(keyVaultKeyRotationPolicyDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -125,6 +131,48 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 
+			"rotation_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expire_after": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: commonValidate.ISO8601Duration,
+						},
+
+						"automatic": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"time_after_creation": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: commonValidate.ISO8601Duration,
+									},
+
+									"time_before_expiry": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: commonValidate.ISO8601Duration,
+									},
+								},
+							},
+						},
+
+						"notify_before_expiry": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: commonValidate.ISO8601Duration,
+						},
+					},
+				},
+			},
+
 			// Computed
 			"version": {
 				Type:     pluginsdk.TypeString,
@@ -265,6 +313,13 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if v, ok := d.GetOk("rotation_policy"); ok {
+		policy := expandKeyVaultKeyRotationPolicy(v.([]interface{}))
+		if _, err := keyVaultsClient.KeyRotationPoliciesClient.UpdateKeyRotationPolicy(ctx, *keyVaultBaseUri, name, policy); err != nil {
+			return fmt.Errorf("setting the Rotation Policy for Key %q (Key Vault %q): %+v", name, *keyVaultBaseUri, err)
+		}
+	}
+
 	d.SetId(*read.Key.Kid)
 
 	return resourceKeyVaultKeyRead(d, meta)
@@ -332,6 +387,13 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if d.HasChange("rotation_policy") {
+		policy := expandKeyVaultKeyRotationPolicy(d.Get("rotation_policy").([]interface{}))
+		if _, err := keyVaultsClient.KeyRotationPoliciesClient.UpdateKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name, policy); err != nil {
+			return fmt.Errorf("updating the Rotation Policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
 	return resourceKeyVaultKeyRead(d, meta)
 }
 
@@ -417,6 +479,23 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
+	rotationPolicy, err := keyVaultsClient.KeyRotationPoliciesClient.GetKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		// the `getrotationpolicy` permission isn't granted by older access policies, so only surface this
+		// error when a Rotation Policy is being managed
+		if !utils.ResponseWasForbidden(autorest.Response{Response: rotationPolicy.HttpResponse}) || len(d.Get("rotation_policy").([]interface{})) > 0 {
+			return fmt.Errorf("retrieving the Rotation Policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+		log.Printf("[DEBUG] Unable to retrieve the Rotation Policy for Key %q (Key Vault %q) - skipping", id.Name, id.KeyVaultBaseUrl)
+	} else {
+		// Key Vault returns a default Rotation Policy for every Key, so this is only set into the state when
+		// it's been customised or is already being managed
+		includeDefault := len(d.Get("rotation_policy").([]interface{})) > 0
+		if err := d.Set("rotation_policy", flattenKeyVaultKeyRotationPolicy(rotationPolicy.Model, includeDefault)); err != nil {
+			return fmt.Errorf("setting `rotation_policy`: %+v", err)
+		}
+	}
+
 	// Computed
 	d.Set("version", id.Version)
 	d.Set("versionless_id", id.VersionlessID())
@@ -519,3 +598,179 @@ func flattenKeyVaultKeyOptions(input *[]string) []interface{} {
 
 	return results
 }
+
+// keyVaultKeyRotationPolicyDiff validates the relationships between the durations within the `rotation_policy`
+// block, which can't be validated at the field level, such that these are surfaced during the plan
+func keyVaultKeyRotationPolicyDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("rotation_policy") {
+		return nil
+	}
+
+	raw := d.Get("rotation_policy").([]interface{})
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+
+	policy := raw[0].(map[string]interface{})
+	expireAfter := policy["expire_after"].(string)
+
+	if v := policy["automatic"].([]interface{}); len(v) > 0 && v[0] != nil {
+		automatic := v[0].(map[string]interface{})
+		timeAfterCreation := automatic["time_after_creation"].(string)
+		timeBeforeExpiry := automatic["time_before_expiry"].(string)
+
+		if (timeAfterCreation == "") == (timeBeforeExpiry == "") {
+			return fmt.Errorf("exactly one of `time_after_creation` or `time_before_expiry` must be specified within the `automatic` block")
+		}
+		if timeBeforeExpiry != "" {
+			if expireAfter == "" {
+				return fmt.Errorf("`expire_after` must be specified when `time_before_expiry` is set within the `automatic` block")
+			}
+			if !keyVaultKeyDurationIsShorter(timeBeforeExpiry, expireAfter) {
+				return fmt.Errorf("`time_before_expiry` (%s) must be shorter than `expire_after` (%s)", timeBeforeExpiry, expireAfter)
+			}
+		}
+		if timeAfterCreation != "" && expireAfter != "" && !keyVaultKeyDurationIsShorter(timeAfterCreation, expireAfter) {
+			return fmt.Errorf("`time_after_creation` (%s) must be shorter than `expire_after` (%s)", timeAfterCreation, expireAfter)
+		}
+	}
+
+	if v := policy["notify_before_expiry"].(string); v != "" {
+		if expireAfter == "" {
+			return fmt.Errorf("`expire_after` must be specified when `notify_before_expiry` is set")
+		}
+		if !keyVaultKeyDurationIsShorter(v, expireAfter) {
+			return fmt.Errorf("`notify_before_expiry` (%s) must be shorter than `expire_after` (%s)", v, expireAfter)
+		}
+	}
+
+	return nil
+}
+
+// keyVaultKeyDurationIsShorter returns whether the ISO8601 Duration `first` is shorter than `second` - these
+// are validated at the field level, so an invalid Duration is ignored here
+func keyVaultKeyDurationIsShorter(first, second string) bool {
+	firstPeriod, err := period.Parse(first)
+	if err != nil {
+		return true
+	}
+	secondPeriod, err := period.Parse(second)
+	if err != nil {
+		return true
+	}
+
+	return firstPeriod.DurationApprox() < secondPeriod.DurationApprox()
+}
+
+// keyVaultKeyDefaultNotifyBeforeExpiry is the period before expiry at which Key Vault sends a notification
+// when using the default Rotation Policy
+const keyVaultKeyDefaultNotifyBeforeExpiry = "P30D"
+
+func expandKeyVaultKeyRotationPolicy(input []interface{}) keyrotationpolicies.KeyRotationPolicy {
+	// removing the block resets the Rotation Policy back to the default, which only sends a notification
+	if len(input) == 0 || input[0] == nil {
+		return keyrotationpolicies.KeyRotationPolicy{
+			LifetimeActions: &[]keyrotationpolicies.LifetimeAction{
+				keyVaultKeyRotationPolicyLifetimeAction(keyrotationpolicies.ActionTypeNotify, "", keyVaultKeyDefaultNotifyBeforeExpiry),
+			},
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	expireAfter := raw["expire_after"].(string)
+	actions := make([]keyrotationpolicies.LifetimeAction, 0)
+
+	if v := raw["automatic"].([]interface{}); len(v) > 0 && v[0] != nil {
+		automatic := v[0].(map[string]interface{})
+		timeAfterCreation := automatic["time_after_creation"].(string)
+		timeBeforeExpiry := automatic["time_before_expiry"].(string)
+
+		actions = append(actions, keyVaultKeyRotationPolicyLifetimeAction(keyrotationpolicies.ActionTypeRotate, timeAfterCreation, timeBeforeExpiry))
+	}
+
+	if v := raw["notify_before_expiry"].(string); v != "" {
+		actions = append(actions, keyVaultKeyRotationPolicyLifetimeAction(keyrotationpolicies.ActionTypeNotify, "", v))
+	}
+
+	policy := keyrotationpolicies.KeyRotationPolicy{
+		LifetimeActions: &actions,
+	}
+	if expireAfter != "" {
+		policy.Attributes = &keyrotationpolicies.KeyRotationPolicyAttributes{
+			ExpiryTime: utils.String(expireAfter),
+		}
+	}
+
+	return policy
+}
+
+func keyVaultKeyRotationPolicyLifetimeAction(actionType keyrotationpolicies.ActionType, timeAfterCreate, timeBeforeExpiry string) keyrotationpolicies.LifetimeAction {
+	trigger := keyrotationpolicies.LifetimeActionTrigger{}
+	if timeAfterCreate != "" {
+		trigger.TimeAfterCreate = utils.String(timeAfterCreate)
+	}
+	if timeBeforeExpiry != "" {
+		trigger.TimeBeforeExpiry = utils.String(timeBeforeExpiry)
+	}
+
+	return keyrotationpolicies.LifetimeAction{
+		Action: &keyrotationpolicies.LifetimeActionType{
+			Type: &actionType,
+		},
+		Trigger: &trigger,
+	}
+}
+
+func flattenKeyVaultKeyRotationPolicy(input *keyrotationpolicies.KeyRotationPolicy, includeDefault bool) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	expireAfter := ""
+	if attributes := input.Attributes; attributes != nil && attributes.ExpiryTime != nil {
+		expireAfter = *attributes.ExpiryTime
+	}
+
+	automatic := make([]interface{}, 0)
+	notifyBeforeExpiry := ""
+	if input.LifetimeActions != nil {
+		for _, action := range *input.LifetimeActions {
+			if action.Action == nil || action.Action.Type == nil || action.Trigger == nil {
+				continue
+			}
+
+			timeAfterCreate := ""
+			if v := action.Trigger.TimeAfterCreate; v != nil {
+				timeAfterCreate = *v
+			}
+			timeBeforeExpiry := ""
+			if v := action.Trigger.TimeBeforeExpiry; v != nil {
+				timeBeforeExpiry = *v
+			}
+
+			// the API returns the Action Type in lower-case
+			switch strings.ToLower(string(*action.Action.Type)) {
+			case strings.ToLower(string(keyrotationpolicies.ActionTypeRotate)):
+				automatic = append(automatic, map[string]interface{}{
+					"time_after_creation": timeAfterCreate,
+					"time_before_expiry":  timeBeforeExpiry,
+				})
+			case strings.ToLower(string(keyrotationpolicies.ActionTypeNotify)):
+				notifyBeforeExpiry = timeBeforeExpiry
+			}
+		}
+	}
+
+	isDefault := expireAfter == "" && len(automatic) == 0 && (notifyBeforeExpiry == "" || notifyBeforeExpiry == keyVaultKeyDefaultNotifyBeforeExpiry)
+	if isDefault && !includeDefault {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"expire_after":         expireAfter,
+			"automatic":            automatic,
+			"notify_before_expiry": notifyBeforeExpiry,
+		},
+	}
+}
@@ -3,6 +3,7 @@ package keyvault_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -146,6 +147,64 @@ func TestAccKeyVaultKey_complete(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultKey_rotationPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicRSA(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("key_size"),
+		{
+			Config: r.rotationPolicy(data, "P90D", "time_after_creation = \"P60D\""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.0.expire_after").HasValue("P90D"),
+				check.That(data.ResourceName).Key("rotation_policy.0.automatic.0.time_after_creation").HasValue("P60D"),
+			),
+		},
+		data.ImportStep("key_size"),
+		{
+			Config: r.rotationPolicy(data, "P180D", "time_before_expiry = \"P30D\""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.0.expire_after").HasValue("P180D"),
+				check.That(data.ResourceName).Key("rotation_policy.0.automatic.0.time_before_expiry").HasValue("P30D"),
+			),
+		},
+		data.ImportStep("key_size"),
+		{
+			Config: r.basicRSA(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("key_size"),
+	})
+}
+
+func TestAccKeyVaultKey_rotationPolicyInvalid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.rotationPolicy(data, "P90D", "time_before_expiry = \"P120D\""),
+			ExpectError: regexp.MustCompile("`time_before_expiry` \\(P120D\\) must be shorter than `expire_after` \\(P90D\\)"),
+		},
+		{
+			Config:      r.rotationPolicy(data, "P90D", "time_after_creation = \"P30D\"\n      time_before_expiry  = \"P30D\""),
+			ExpectError: regexp.MustCompile("exactly one of `time_after_creation` or `time_before_expiry` must be specified"),
+		},
+	})
+}
+
 func TestAccKeyVaultKey_softDeleteRecovery(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
 	r := KeyVaultKeyResource{}
@@ -484,6 +543,41 @@ resource "azurerm_key_vault_key" "test" {
 `, r.templateStandard(data), data.RandomString)
 }
 
+func (r KeyVaultKeyResource) rotationPolicy(data acceptance.TestData, expireAfter, automatic string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  rotation_policy {
+    expire_after         = "%s"
+    notify_before_expiry = "P29D"
+
+    automatic {
+      %s
+    }
+  }
+}
+`, r.templateStandard(data), data.RandomString, expireAfter, automatic)
+}
+
 func (r KeyVaultKeyResource) basicRSAHSM(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -832,8 +926,10 @@ resource "azurerm_key_vault" "test" {
       "Create",
       "Delete",
       "Get",
+      "GetRotationPolicy",
       "Purge",
       "Recover",
+      "SetRotationPolicy",
       "Update",
     ]
 
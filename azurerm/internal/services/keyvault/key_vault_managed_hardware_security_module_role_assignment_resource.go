@@ -0,0 +1,186 @@
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/go-uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/sdk/roleassignments"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+// managedHSMRoleScopeRegex matches the scopes which Role Assignments can be made at within a Managed HSM, e.g. `/` or `/keys`
+var managedHSMRoleScopeRegex = regexp.MustCompile(`^/([a-zA-Z0-9-]+(/[a-zA-Z0-9-]+)*)?$`)
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleAssignment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentCreate,
+		Read:   resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentRead,
+		Delete: resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ManagedHSMRoleAssignmentID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"vault_base_url": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+				DiffSuppressFunc: func(_, old, new string, _ *pluginsdk.ResourceData) bool {
+					return strings.TrimSuffix(old, "/") == strings.TrimSuffix(new, "/")
+				},
+			},
+
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"scope": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(managedHSMRoleScopeRegex, "`scope` must start with `/` and not end with `/` (other than the root scope), for example `/` or `/keys`"),
+			},
+
+			"role_definition_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"principal_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"resource_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmRoleAssignmentsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	if name == "" {
+		v, err := uuid.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("generating UUID for Role Assignment: %+v", err)
+		}
+		name = v
+	}
+
+	id, err := parse.NewManagedHSMRoleAssignmentID(d.Get("vault_base_url").(string), d.Get("scope").(string), name)
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, id.ManagedHSMBaseUrl, id.Path())
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_key_vault_managed_hardware_security_module_role_assignment", id.ID())
+	}
+
+	parameters := roleassignments.RoleAssignmentCreateParameters{
+		Properties: roleassignments.RoleAssignmentProperties{
+			PrincipalId:      d.Get("principal_id").(string),
+			RoleDefinitionId: d.Get("role_definition_id").(string),
+		},
+	}
+	if _, err := client.Create(ctx, id.ManagedHSMBaseUrl, id.Path(), parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentRead(d, meta)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmRoleAssignmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMRoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ManagedHSMBaseUrl, id.Path())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("vault_base_url", id.ManagedHSMBaseUrl)
+	d.Set("name", id.Name)
+	d.Set("scope", id.Scope)
+
+	if model := resp.Model; model != nil {
+		d.Set("resource_id", model.Id)
+
+		if props := model.Properties; props != nil {
+			d.Set("principal_id", props.PrincipalId)
+			d.Set("role_definition_id", props.RoleDefinitionId)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmRoleAssignmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMRoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ManagedHSMBaseUrl, id.Path()); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
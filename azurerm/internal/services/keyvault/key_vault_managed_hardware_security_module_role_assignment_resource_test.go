@@ -0,0 +1,155 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource struct {
+	vaultBaseUrl string
+}
+
+// Role Assignments can only be managed within a Managed HSM which has been activated by downloading its
+// Security Domain, which isn't possible from Terraform - so these tests run against an existing Managed HSM
+func newKeyVaultManagedHardwareSecurityModuleRoleAssignmentResource(t *testing.T) KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource {
+	vaultBaseUrl := os.Getenv("ARM_TEST_MANAGED_HSM_BASE_URL")
+	if vaultBaseUrl == "" {
+		t.Skip("Skipping as `ARM_TEST_MANAGED_HSM_BASE_URL` was not specified")
+	}
+
+	return KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{
+		vaultBaseUrl: vaultBaseUrl,
+	}
+}
+
+func TestAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := newKeyVaultManagedHardwareSecurityModuleRoleAssignmentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := newKeyVaultManagedHardwareSecurityModuleRoleAssignmentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(_ acceptance.TestData) string {
+			return r.requiresImport()
+		}),
+	})
+}
+
+func TestAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_keysScope(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := newKeyVaultManagedHardwareSecurityModuleRoleAssignmentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keysScope(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scope").HasValue("/keys"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedHSMRoleAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.KeyVault.ManagedHsmRoleAssignmentsClient.Get(ctx, id.ManagedHSMBaseUrl, id.Path())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) template() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {
+}
+
+data "azurerm_key_vault_managed_hardware_security_module_role_definition" "test" {
+  vault_base_url = %q
+  role_name      = "Managed HSM Crypto User"
+}
+`, r.vaultBaseUrl)
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) basic() string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "test" {
+  vault_base_url     = %q
+  scope              = "/"
+  role_definition_id = data.azurerm_key_vault_managed_hardware_security_module_role_definition.test.resource_id
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, r.template(), r.vaultBaseUrl)
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) requiresImport() string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "import" {
+  vault_base_url     = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.vault_base_url
+  name               = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.name
+  scope              = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.scope
+  role_definition_id = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.role_definition_id
+  principal_id       = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.principal_id
+}
+`, r.basic())
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) keysScope() string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "test" {
+  vault_base_url     = %q
+  scope              = "/keys"
+  role_definition_id = data.azurerm_key_vault_managed_hardware_security_module_role_definition.test.resource_id
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, r.template(), r.vaultBaseUrl)
+}
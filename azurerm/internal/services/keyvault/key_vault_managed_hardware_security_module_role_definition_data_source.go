@@ -0,0 +1,208 @@
+package keyvault
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/sdk/roledefinitions"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceKeyVaultManagedHardwareSecurityModuleRoleDefinition() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"vault_base_url": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+				ExactlyOneOf: []string{"name", "role_name"},
+			},
+
+			"role_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"name", "role_name"},
+			},
+
+			"scope": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "/",
+				ValidateFunc: validation.StringMatch(managedHSMRoleScopeRegex, "`scope` must start with `/` and not end with `/` (other than the root scope), for example `/` or `/keys`"),
+			},
+
+			"resource_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"role_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"permission": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"actions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"not_actions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"data_actions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"not_data_actions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"assignable_scopes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmRoleDefinitionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	vaultBaseUrl := d.Get("vault_base_url").(string)
+	scope := d.Get("scope").(string)
+
+	var definition *roledefinitions.RoleDefinition
+	if name := d.Get("name").(string); name != "" {
+		id, err := parse.NewManagedHSMRoleDefinitionID(vaultBaseUrl, scope, name)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ManagedHSMBaseUrl, id.Path())
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		definition = resp.Model
+	} else {
+		roleName := d.Get("role_name").(string)
+		options := roledefinitions.ListOptions{
+			Filter: utils.String(fmt.Sprintf("roleName eq '%s'", roleName)),
+		}
+		resp, err := client.ListComplete(ctx, vaultBaseUrl, strings.TrimSuffix(scope, "/"), options)
+		if err != nil {
+			return fmt.Errorf("listing Role Definitions with the name %q (Managed HSM %q): %+v", roleName, vaultBaseUrl, err)
+		}
+
+		for _, item := range resp.Items {
+			if item.Properties != nil && item.Properties.RoleName != nil && strings.EqualFold(*item.Properties.RoleName, roleName) {
+				v := item
+				definition = &v
+				break
+			}
+		}
+		if definition == nil {
+			return fmt.Errorf("a Role Definition with the name %q was not found (Managed HSM %q)", roleName, vaultBaseUrl)
+		}
+	}
+
+	if definition == nil || definition.Name == nil {
+		return fmt.Errorf("retrieving Role Definition (Managed HSM %q): `name` was nil", vaultBaseUrl)
+	}
+
+	id, err := parse.NewManagedHSMRoleDefinitionID(vaultBaseUrl, scope, *definition.Name)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	d.Set("name", definition.Name)
+	d.Set("resource_id", definition.Id)
+
+	if props := definition.Properties; props != nil {
+		d.Set("role_name", props.RoleName)
+		d.Set("description", props.Description)
+		d.Set("role_type", props.Type)
+
+		if err := d.Set("permission", flattenKeyVaultManagedHSMRoleDefinitionPermissions(props.Permissions)); err != nil {
+			return fmt.Errorf("setting `permission`: %+v", err)
+		}
+		if err := d.Set("assignable_scopes", utils.FlattenStringSlice(props.AssignableScopes)); err != nil {
+			return fmt.Errorf("setting `assignable_scopes`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func flattenKeyVaultManagedHSMRoleDefinitionPermissions(input *[]roledefinitions.Permission) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, permission := range *input {
+		results = append(results, map[string]interface{}{
+			"actions":          utils.FlattenStringSlice(permission.Actions),
+			"not_actions":      utils.FlattenStringSlice(permission.NotActions),
+			"data_actions":     utils.FlattenStringSlice(permission.DataActions),
+			"not_data_actions": utils.FlattenStringSlice(permission.NotDataActions),
+		})
+	}
+
+	return results
+}
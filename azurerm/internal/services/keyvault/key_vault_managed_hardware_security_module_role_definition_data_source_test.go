@@ -0,0 +1,55 @@
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleDefinitionDataSource struct{}
+
+func TestAccDataSourceKeyVaultManagedHardwareSecurityModuleRoleDefinition_byRoleName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_key_vault_managed_hardware_security_module_role_definition", "test")
+	r := newKeyVaultManagedHardwareSecurityModuleRoleAssignmentResource(t)
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue("21dbd100-6940-42c2-9190-5d6cb909625b"),
+				check.That(data.ResourceName).Key("resource_id").Exists(),
+				check.That(data.ResourceName).Key("permission.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceKeyVaultManagedHardwareSecurityModuleRoleDefinition_byName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_key_vault_managed_hardware_security_module_role_definition", "test")
+	r := newKeyVaultManagedHardwareSecurityModuleRoleAssignmentResource(t)
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: KeyVaultManagedHardwareSecurityModuleRoleDefinitionDataSource{}.byName(r.vaultBaseUrl),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("role_name").HasValue("Managed HSM Crypto User"),
+				check.That(data.ResourceName).Key("resource_id").Exists(),
+			),
+		},
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleDefinitionDataSource) byName(vaultBaseUrl string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_key_vault_managed_hardware_security_module_role_definition" "test" {
+  vault_base_url = %q
+  name           = "21dbd100-6940-42c2-9190-5d6cb909625b"
+}
+`, vaultBaseUrl)
+}
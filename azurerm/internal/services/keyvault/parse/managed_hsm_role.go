@@ -0,0 +1,112 @@
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = ManagedHSMRoleId{}
+
+const (
+	managedHSMRoleAssignmentType = "roleAssignments"
+	managedHSMRoleDefinitionType = "roleDefinitions"
+)
+
+// ManagedHSMRoleId is the Data Plane ID of a Role Assignment or a Role Definition within a Managed HSM
+type ManagedHSMRoleId struct {
+	ManagedHSMBaseUrl string
+	Scope             string
+	Type              string
+	Name              string
+}
+
+func NewManagedHSMRoleAssignmentID(managedHSMBaseUrl, scope, name string) (*ManagedHSMRoleId, error) {
+	return newManagedHSMRoleId(managedHSMBaseUrl, scope, managedHSMRoleAssignmentType, name)
+}
+
+func NewManagedHSMRoleDefinitionID(managedHSMBaseUrl, scope, name string) (*ManagedHSMRoleId, error) {
+	return newManagedHSMRoleId(managedHSMBaseUrl, scope, managedHSMRoleDefinitionType, name)
+}
+
+func newManagedHSMRoleId(managedHSMBaseUrl, scope, roleType, name string) (*ManagedHSMRoleId, error) {
+	hsmUrl, err := url.Parse(managedHSMBaseUrl)
+	if err != nil || managedHSMBaseUrl == "" {
+		return nil, fmt.Errorf("parsing %q: %+v", managedHSMBaseUrl, err)
+	}
+	if hostParts := strings.Split(hsmUrl.Host, ":"); len(hostParts) > 1 {
+		hsmUrl.Host = hostParts[0]
+	}
+
+	return &ManagedHSMRoleId{
+		ManagedHSMBaseUrl: fmt.Sprintf("%s://%s/", hsmUrl.Scheme, hsmUrl.Host),
+		Scope:             normalizeManagedHSMRoleScope(scope),
+		Type:              roleType,
+		Name:              name,
+	}, nil
+}
+
+func (id ManagedHSMRoleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Scope %q", id.Scope),
+		fmt.Sprintf("Managed HSM %q", id.ManagedHSMBaseUrl),
+	}
+	typeName := "Role Assignment"
+	if id.Type == managedHSMRoleDefinitionType {
+		typeName = "Role Definition"
+	}
+	return fmt.Sprintf("%s (%s)", typeName, strings.Join(segments, " / "))
+}
+
+func (id ManagedHSMRoleId) ID() string {
+	// example: https://example-hsm.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/assignment1
+	return fmt.Sprintf("%s%s", strings.TrimSuffix(id.ManagedHSMBaseUrl, "/"), id.Path())
+}
+
+// Path returns the path of this Role Assignment or Role Definition, relative to the Managed HSM
+func (id ManagedHSMRoleId) Path() string {
+	return fmt.Sprintf("%s/providers/Microsoft.Authorization/%s/%s", strings.TrimSuffix(id.Scope, "/"), id.Type, id.Name)
+}
+
+// ManagedHSMRoleAssignmentID parses a Managed HSM Role Assignment ID into a ManagedHSMRoleId struct
+func ManagedHSMRoleAssignmentID(input string) (*ManagedHSMRoleId, error) {
+	return parseManagedHSMRoleId(input, managedHSMRoleAssignmentType)
+}
+
+// ManagedHSMRoleDefinitionID parses a Managed HSM Role Definition ID into a ManagedHSMRoleId struct
+func ManagedHSMRoleDefinitionID(input string) (*ManagedHSMRoleId, error) {
+	return parseManagedHSMRoleId(input, managedHSMRoleDefinitionType)
+}
+
+func parseManagedHSMRoleId(input, roleType string) (*ManagedHSMRoleId, error) {
+	// example: https://example-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/assignment1
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Managed HSM Role ID %q: %+v", input, err)
+	}
+
+	delimiter := fmt.Sprintf("/providers/Microsoft.Authorization/%s/", roleType)
+	index := strings.LastIndex(idURL.Path, delimiter)
+	if index == -1 {
+		return nil, fmt.Errorf("expected %q to contain %q", input, strings.TrimSuffix(delimiter, "/"))
+	}
+
+	name := strings.TrimSuffix(idURL.Path[index+len(delimiter):], "/")
+	if name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("expected a single segment name after %q in %q", strings.TrimSuffix(delimiter, "/"), input)
+	}
+
+	return &ManagedHSMRoleId{
+		ManagedHSMBaseUrl: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+		Scope:             normalizeManagedHSMRoleScope(idURL.Path[:index]),
+		Type:              roleType,
+		Name:              name,
+	}, nil
+}
+
+func normalizeManagedHSMRoleScope(scope string) string {
+	return "/" + strings.Trim(scope, "/")
+}
@@ -0,0 +1,156 @@
+package parse
+
+import "testing"
+
+func TestNewManagedHSMRoleAssignmentID(t *testing.T) {
+	cases := []struct {
+		Scenario    string
+		BaseUrl     string
+		Scope       string
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Scenario:    "empty values",
+			BaseUrl:     "",
+			Scope:       "/",
+			ExpectError: true,
+		},
+		{
+			Scenario: "root scope",
+			BaseUrl:  "https://test.managedhsm.azure.net",
+			Scope:    "/",
+			Expected: "https://test.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/assignment1",
+		},
+		{
+			Scenario: "keys scope, with port",
+			BaseUrl:  "https://test.managedhsm.azure.net:443/",
+			Scope:    "/keys",
+			Expected: "https://test.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/assignment1",
+		},
+	}
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Scenario)
+		id, err := NewManagedHSMRoleAssignmentID(tc.BaseUrl, tc.Scope, "assignment1")
+		if err != nil {
+			if !tc.ExpectError {
+				t.Fatalf("Got error for New Resource ID '%s': %+v", tc.BaseUrl, err)
+			}
+			continue
+		}
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Scenario)
+		}
+		if id.ID() != tc.Expected {
+			t.Fatalf("Expected id for %q to be %q, got %q", tc.BaseUrl, tc.Expected, id.ID())
+		}
+	}
+}
+
+func TestManagedHSMRoleAssignmentID(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected *ManagedHSMRoleId
+	}{
+		{
+			// empty
+			Input: "",
+		},
+		{
+			// not a url
+			Input: "providers/Microsoft.Authorization/roleAssignments/assignment1",
+		},
+		{
+			// missing name
+			Input: "https://test.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/",
+		},
+		{
+			// role definition
+			Input: "https://test.managedhsm.azure.net/providers/Microsoft.Authorization/roleDefinitions/definition1",
+		},
+		{
+			// root scope
+			Input: "https://test.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			Expected: &ManagedHSMRoleId{
+				ManagedHSMBaseUrl: "https://test.managedhsm.azure.net/",
+				Scope:             "/",
+				Type:              "roleAssignments",
+				Name:              "assignment1",
+			},
+		},
+		{
+			// keys scope
+			Input: "https://test.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			Expected: &ManagedHSMRoleId{
+				ManagedHSMBaseUrl: "https://test.managedhsm.azure.net/",
+				Scope:             "/keys",
+				Type:              "roleAssignments",
+				Name:              "assignment1",
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagedHSMRoleAssignmentID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Expected == nil {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
+
+func TestManagedHSMRoleDefinitionID(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected *ManagedHSMRoleId
+	}{
+		{
+			// role assignment
+			Input: "https://test.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/assignment1",
+		},
+		{
+			Input: "https://test.managedhsm.azure.net/providers/Microsoft.Authorization/roleDefinitions/definition1",
+			Expected: &ManagedHSMRoleId{
+				ManagedHSMBaseUrl: "https://test.managedhsm.azure.net/",
+				Scope:             "/",
+				Type:              "roleDefinitions",
+				Name:              "definition1",
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagedHSMRoleDefinitionID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Expected == nil {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package keyrotationpolicies
+
+import "github.com/Azure/go-autorest/autorest"
+
+type KeyRotationPoliciesClient struct {
+	Client autorest.Client
+}
+
+func NewKeyRotationPoliciesClient() KeyRotationPoliciesClient {
+	return KeyRotationPoliciesClient{
+		Client: autorest.NewClientWithUserAgent(userAgent()),
+	}
+}
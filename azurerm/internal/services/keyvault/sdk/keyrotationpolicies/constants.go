@@ -0,0 +1,8 @@
+package keyrotationpolicies
+
+type ActionType string
+
+const (
+	ActionTypeNotify ActionType = "Notify"
+	ActionTypeRotate ActionType = "Rotate"
+)
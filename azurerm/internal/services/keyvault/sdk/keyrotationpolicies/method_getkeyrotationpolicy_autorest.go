@@ -0,0 +1,65 @@
+package keyrotationpolicies
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetKeyRotationPolicyResponse struct {
+	HttpResponse *http.Response
+	Model        *KeyRotationPolicy
+}
+
+// GetKeyRotationPolicy ...
+func (c KeyRotationPoliciesClient) GetKeyRotationPolicy(ctx context.Context, keyVaultBaseUrl string, keyName string) (result GetKeyRotationPolicyResponse, err error) {
+	req, err := c.preparerForGetKeyRotationPolicy(ctx, keyVaultBaseUrl, keyName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyrotationpolicies.KeyRotationPoliciesClient", "GetKeyRotationPolicy", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyrotationpolicies.KeyRotationPoliciesClient", "GetKeyRotationPolicy", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetKeyRotationPolicy(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyrotationpolicies.KeyRotationPoliciesClient", "GetKeyRotationPolicy", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetKeyRotationPolicy prepares the GetKeyRotationPolicy request.
+func (c KeyRotationPoliciesClient) preparerForGetKeyRotationPolicy(ctx context.Context, keyVaultBaseUrl string, keyName string) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(keyVaultBaseUrl),
+		autorest.WithPath(fmt.Sprintf("/keys/%s/rotationpolicy", autorest.Encode("path", keyName))),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetKeyRotationPolicy handles the response to the GetKeyRotationPolicy request. The method always
+// closes the http.Response Body.
+func (c KeyRotationPoliciesClient) responderForGetKeyRotationPolicy(resp *http.Response) (result GetKeyRotationPolicyResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
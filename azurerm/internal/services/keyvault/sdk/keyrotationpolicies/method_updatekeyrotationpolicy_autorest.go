@@ -0,0 +1,66 @@
+package keyrotationpolicies
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type UpdateKeyRotationPolicyResponse struct {
+	HttpResponse *http.Response
+	Model        *KeyRotationPolicy
+}
+
+// UpdateKeyRotationPolicy ...
+func (c KeyRotationPoliciesClient) UpdateKeyRotationPolicy(ctx context.Context, keyVaultBaseUrl string, keyName string, input KeyRotationPolicy) (result UpdateKeyRotationPolicyResponse, err error) {
+	req, err := c.preparerForUpdateKeyRotationPolicy(ctx, keyVaultBaseUrl, keyName, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyrotationpolicies.KeyRotationPoliciesClient", "UpdateKeyRotationPolicy", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyrotationpolicies.KeyRotationPoliciesClient", "UpdateKeyRotationPolicy", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForUpdateKeyRotationPolicy(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyrotationpolicies.KeyRotationPoliciesClient", "UpdateKeyRotationPolicy", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForUpdateKeyRotationPolicy prepares the UpdateKeyRotationPolicy request.
+func (c KeyRotationPoliciesClient) preparerForUpdateKeyRotationPolicy(ctx context.Context, keyVaultBaseUrl string, keyName string, input KeyRotationPolicy) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(keyVaultBaseUrl),
+		autorest.WithPath(fmt.Sprintf("/keys/%s/rotationpolicy", autorest.Encode("path", keyName))),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForUpdateKeyRotationPolicy handles the response to the UpdateKeyRotationPolicy request. The method always
+// closes the http.Response Body.
+func (c KeyRotationPoliciesClient) responderForUpdateKeyRotationPolicy(resp *http.Response) (result UpdateKeyRotationPolicyResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
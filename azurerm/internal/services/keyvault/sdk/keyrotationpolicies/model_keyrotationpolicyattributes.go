@@ -0,0 +1,7 @@
+package keyrotationpolicies
+
+type KeyRotationPolicyAttributes struct {
+	Created    *int64  `json:"created,omitempty"`
+	ExpiryTime *string `json:"expiryTime,omitempty"`
+	Updated    *int64  `json:"updated,omitempty"`
+}
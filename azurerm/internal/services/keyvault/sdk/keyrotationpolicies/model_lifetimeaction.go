@@ -0,0 +1,6 @@
+package keyrotationpolicies
+
+type LifetimeAction struct {
+	Action  *LifetimeActionType    `json:"action,omitempty"`
+	Trigger *LifetimeActionTrigger `json:"trigger,omitempty"`
+}
@@ -0,0 +1,6 @@
+package keyrotationpolicies
+
+type LifetimeActionTrigger struct {
+	TimeAfterCreate  *string `json:"timeAfterCreate,omitempty"`
+	TimeBeforeExpiry *string `json:"timeBeforeExpiry,omitempty"`
+}
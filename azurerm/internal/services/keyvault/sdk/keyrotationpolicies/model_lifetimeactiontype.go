@@ -0,0 +1,5 @@
+package keyrotationpolicies
+
+type LifetimeActionType struct {
+	Type *ActionType `json:"type,omitempty"`
+}
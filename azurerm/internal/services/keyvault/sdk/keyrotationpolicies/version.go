@@ -0,0 +1,9 @@
+package keyrotationpolicies
+
+import "fmt"
+
+const defaultApiVersion = "7.3"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/keyrotationpolicies/%s", defaultApiVersion)
+}
@@ -0,0 +1,13 @@
+package roleassignments
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RoleAssignmentsClient struct {
+	Client autorest.Client
+}
+
+func NewRoleAssignmentsClient() RoleAssignmentsClient {
+	return RoleAssignmentsClient{
+		Client: autorest.NewClientWithUserAgent(userAgent()),
+	}
+}
@@ -0,0 +1,8 @@
+package roleassignments
+
+type RoleAssignment struct {
+	Id         *string                            `json:"id,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *RoleAssignmentPropertiesWithScope `json:"properties,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+}
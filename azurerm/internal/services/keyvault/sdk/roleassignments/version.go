@@ -0,0 +1,9 @@
+package roleassignments
+
+import "fmt"
+
+const defaultApiVersion = "7.2"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/roleassignments/%s", defaultApiVersion)
+}
@@ -0,0 +1,13 @@
+package roledefinitions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RoleDefinitionsClient struct {
+	Client autorest.Client
+}
+
+func NewRoleDefinitionsClient() RoleDefinitionsClient {
+	return RoleDefinitionsClient{
+		Client: autorest.NewClientWithUserAgent(userAgent()),
+	}
+}
@@ -0,0 +1,135 @@
+package roledefinitions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListResponse struct {
+	HttpResponse *http.Response
+	Model        *[]RoleDefinition
+
+	nextLink *string
+}
+
+type ListCompleteResult struct {
+	Items []RoleDefinition
+}
+
+type ListOptions struct {
+	Filter *string
+}
+
+func DefaultListOptions() ListOptions {
+	return ListOptions{}
+}
+
+func (o ListOptions) toQueryString() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.Filter != nil {
+		out["$filter"] = *o.Filter
+	}
+
+	return out
+}
+
+// List ...
+func (c RoleDefinitionsClient) List(ctx context.Context, managedHSMBaseUrl string, scope string, options ListOptions) (result ListResponse, err error) {
+	req, err := c.preparerForList(ctx, managedHSMBaseUrl, scope, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roledefinitions.RoleDefinitionsClient", "List", nil, "Failure preparing request")
+		return
+	}
+
+	return c.senderForList(req)
+}
+
+// ListComplete retrieves all of the results into a single object
+func (c RoleDefinitionsClient) ListComplete(ctx context.Context, managedHSMBaseUrl string, scope string, options ListOptions) (ListCompleteResult, error) {
+	items := make([]RoleDefinition, 0)
+
+	page, err := c.List(ctx, managedHSMBaseUrl, scope, options)
+	if err != nil {
+		return ListCompleteResult{}, fmt.Errorf("loading the initial page: %+v", err)
+	}
+	if page.Model != nil {
+		items = append(items, *page.Model...)
+	}
+
+	for page.nextLink != nil && *page.nextLink != "" {
+		req, err := c.preparerForListWithNextLink(ctx, *page.nextLink)
+		if err != nil {
+			return ListCompleteResult{}, fmt.Errorf("preparing the request for the next page: %+v", err)
+		}
+
+		page, err = c.senderForList(req)
+		if err != nil {
+			return ListCompleteResult{}, fmt.Errorf("loading the next page: %+v", err)
+		}
+		if page.Model != nil {
+			items = append(items, *page.Model...)
+		}
+	}
+
+	return ListCompleteResult{
+		Items: items,
+	}, nil
+}
+
+// preparerForList prepares the List request.
+func (c RoleDefinitionsClient) preparerForList(ctx context.Context, managedHSMBaseUrl string, scope string, options ListOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	for k, v := range options.toQueryString() {
+		queryParameters[k] = autorest.Encode("query", v)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(managedHSMBaseUrl),
+		autorest.WithPath(fmt.Sprintf("%s/providers/Microsoft.Authorization/roleDefinitions", scope)),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// preparerForListWithNextLink prepares the List request with the given nextLink token.
+func (c RoleDefinitionsClient) preparerForListWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(nextLink))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForList sends the List request and handles the response. The method always
+// closes the http.Response Body.
+func (c RoleDefinitionsClient) senderForList(req *http.Request) (result ListResponse, err error) {
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roledefinitions.RoleDefinitionsClient", "List", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	var respObj RoleDefinitionListResult
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roledefinitions.RoleDefinitionsClient", "List", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	result.Model = respObj.Value
+	result.nextLink = respObj.NextLink
+	return
+}
@@ -0,0 +1,8 @@
+package roledefinitions
+
+type Permission struct {
+	Actions        *[]string `json:"actions,omitempty"`
+	DataActions    *[]string `json:"dataActions,omitempty"`
+	NotActions     *[]string `json:"notActions,omitempty"`
+	NotDataActions *[]string `json:"notDataActions,omitempty"`
+}
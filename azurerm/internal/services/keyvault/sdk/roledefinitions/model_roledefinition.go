@@ -0,0 +1,8 @@
+package roledefinitions
+
+type RoleDefinition struct {
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *RoleDefinitionProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package roledefinitions
+
+type RoleDefinitionListResult struct {
+	NextLink *string           `json:"nextLink,omitempty"`
+	Value    *[]RoleDefinition `json:"value,omitempty"`
+}
@@ -0,0 +1,9 @@
+package roledefinitions
+
+type RoleDefinitionProperties struct {
+	AssignableScopes *[]string     `json:"assignableScopes,omitempty"`
+	Description      *string       `json:"description,omitempty"`
+	Permissions      *[]Permission `json:"permissions,omitempty"`
+	RoleName         *string       `json:"roleName,omitempty"`
+	Type             *string       `json:"type,omitempty"`
+}
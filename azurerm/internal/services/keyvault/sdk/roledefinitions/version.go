@@ -0,0 +1,9 @@
+package roledefinitions
+
+import "fmt"
+
+const defaultApiVersion = "7.2"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/roledefinitions/%s", defaultApiVersion)
+}
@@ -0,0 +1,773 @@
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	logAnalyticsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/sdk/datacollectionrules"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceMonitorDataCollectionRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorDataCollectionRuleCreateUpdate,
+		Read:   resourceMonitorDataCollectionRuleRead,
+		Update: resourceMonitorDataCollectionRuleCreateUpdate,
+		Delete: resourceMonitorDataCollectionRuleDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DataCollectionRuleID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datacollectionrules.KnownDataCollectionRuleResourceKindLinux),
+					string(datacollectionrules.KnownDataCollectionRuleResourceKindWindows),
+				}, false),
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"data_sources": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"extension": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"extension_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": dataCollectionRuleStreamsSchema(),
+
+									"extension_json": {
+										Type:             pluginsdk.TypeString,
+										Optional:         true,
+										ValidateFunc:     validation.StringIsJSON,
+										DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+									},
+
+									"input_data_sources": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+
+						"performance_counter": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": dataCollectionRuleStreamsSchema(),
+
+									"sampling_frequency_in_seconds": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 300),
+									},
+
+									"counter_specifiers": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+
+						"syslog": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": dataCollectionRuleStreamsSchema(),
+
+									"facility_names": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesAny),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesAuth),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesAuthpriv),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesCron),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesDaemon),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesKern),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesLocalZero),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesLocalOne),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesLocalTwo),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesLocalThree),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesLocalFour),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesLocalFive),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesLocalSix),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesLocalSeven),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesLpr),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesMail),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesMark),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesNews),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesSyslog),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesUser),
+												string(datacollectionrules.KnownSyslogDataSourceFacilityNamesUucp),
+											}, false),
+										},
+									},
+
+									"log_levels": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(datacollectionrules.KnownSyslogDataSourceLogLevelsAny),
+												string(datacollectionrules.KnownSyslogDataSourceLogLevelsDebug),
+												string(datacollectionrules.KnownSyslogDataSourceLogLevelsInfo),
+												string(datacollectionrules.KnownSyslogDataSourceLogLevelsNotice),
+												string(datacollectionrules.KnownSyslogDataSourceLogLevelsWarning),
+												string(datacollectionrules.KnownSyslogDataSourceLogLevelsError),
+												string(datacollectionrules.KnownSyslogDataSourceLogLevelsCritical),
+												string(datacollectionrules.KnownSyslogDataSourceLogLevelsAlert),
+												string(datacollectionrules.KnownSyslogDataSourceLogLevelsEmergency),
+											}, false),
+										},
+									},
+								},
+							},
+						},
+
+						"windows_event_log": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": dataCollectionRuleStreamsSchema(),
+
+									"x_path_queries": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"destinations": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"azure_monitor_metrics": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+							AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics"},
+						},
+
+						"log_analytics": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"workspace_resource_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceID,
+									},
+								},
+							},
+							AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics"},
+						},
+					},
+				},
+			},
+
+			"data_flow": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"streams": dataCollectionRuleStreamsSchema(),
+
+						"destinations": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"immutable_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func dataCollectionRuleStreamsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Required: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(datacollectionrules.KnownDataFlowStreamsMicrosoftEvent),
+				string(datacollectionrules.KnownDataFlowStreamsMicrosoftInsightsMetrics),
+				string(datacollectionrules.KnownDataFlowStreamsMicrosoftPerf),
+				string(datacollectionrules.KnownDataFlowStreamsMicrosoftSyslog),
+				string(datacollectionrules.KnownDataFlowStreamsMicrosoftWindowsEvent),
+			}, false),
+		},
+	}
+}
+
+func resourceMonitorDataCollectionRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDataCollectionRuleID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_monitor_data_collection_rule", id.ID())
+		}
+	}
+
+	dataSources, err := expandDataCollectionRuleDataSources(d.Get("data_sources").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `data_sources`: %+v", err)
+	}
+
+	dataCollectionRuleTags := tags.ToTypedObject(tags.Expand(d.Get("tags").(map[string]interface{})))
+	parameters := datacollectionrules.DataCollectionRuleResource{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &datacollectionrules.DataCollectionRule{
+			DataFlows:    expandDataCollectionRuleDataFlows(d.Get("data_flow").([]interface{})),
+			DataSources:  dataSources,
+			Destinations: expandDataCollectionRuleDestinations(d.Get("destinations").([]interface{})),
+		},
+		Tags: &dataCollectionRuleTags,
+	}
+
+	if v := d.Get("kind").(string); v != "" {
+		kind := datacollectionrules.KnownDataCollectionRuleResourceKind(v)
+		parameters.Kind = &kind
+	}
+
+	if v := d.Get("description").(string); v != "" {
+		parameters.Properties.Description = utils.String(v)
+	}
+
+	if _, err := client.Create(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMonitorDataCollectionRuleRead(d, meta)
+}
+
+func resourceMonitorDataCollectionRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataCollectionRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		kind := ""
+		if model.Kind != nil {
+			kind = string(*model.Kind)
+		}
+		d.Set("kind", kind)
+
+		if props := model.Properties; props != nil {
+			d.Set("description", props.Description)
+			d.Set("immutable_id", props.ImmutableId)
+
+			dataSources, err := flattenDataCollectionRuleDataSources(props.DataSources)
+			if err != nil {
+				return fmt.Errorf("flattening `data_sources`: %+v", err)
+			}
+			if err := d.Set("data_sources", dataSources); err != nil {
+				return fmt.Errorf("setting `data_sources`: %+v", err)
+			}
+
+			if err := d.Set("destinations", flattenDataCollectionRuleDestinations(props.Destinations)); err != nil {
+				return fmt.Errorf("setting `destinations`: %+v", err)
+			}
+
+			if err := d.Set("data_flow", flattenDataCollectionRuleDataFlows(props.DataFlows)); err != nil {
+				return fmt.Errorf("setting `data_flow`: %+v", err)
+			}
+		}
+
+		dataCollectionRuleTags := map[string]string{}
+		if model.Tags != nil {
+			dataCollectionRuleTags = *model.Tags
+		}
+		return tags.FlattenAndSet(d, tags.FromTypedObject(dataCollectionRuleTags))
+	}
+
+	return nil
+}
+
+func resourceMonitorDataCollectionRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataCollectionRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandDataCollectionRuleStreams(input []interface{}) *[]datacollectionrules.KnownDataFlowStreams {
+	results := make([]datacollectionrules.KnownDataFlowStreams, 0)
+
+	for _, item := range input {
+		results = append(results, datacollectionrules.KnownDataFlowStreams(item.(string)))
+	}
+
+	return &results
+}
+
+func flattenDataCollectionRuleStreams(input *[]datacollectionrules.KnownDataFlowStreams) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, string(item))
+	}
+
+	return results
+}
+
+func expandDataCollectionRuleDataSources(input []interface{}) (*datacollectionrules.DataSources, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	extensions, err := expandDataCollectionRuleExtensions(v["extension"].([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &datacollectionrules.DataSources{
+		Extensions:          extensions,
+		PerformanceCounters: expandDataCollectionRulePerformanceCounters(v["performance_counter"].([]interface{})),
+		Syslog:              expandDataCollectionRuleSyslog(v["syslog"].([]interface{})),
+		WindowsEventLogs:    expandDataCollectionRuleWindowsEventLogs(v["windows_event_log"].([]interface{})),
+	}, nil
+}
+
+func expandDataCollectionRuleExtensions(input []interface{}) (*[]datacollectionrules.ExtensionDataSource, error) {
+	results := make([]datacollectionrules.ExtensionDataSource, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		extension := datacollectionrules.ExtensionDataSource{
+			Name:             utils.String(v["name"].(string)),
+			ExtensionName:    v["extension_name"].(string),
+			Streams:          expandDataCollectionRuleStreams(v["streams"].(*pluginsdk.Set).List()),
+			InputDataSources: utils.ExpandStringSlice(v["input_data_sources"].([]interface{})),
+		}
+
+		if extensionJson := v["extension_json"].(string); extensionJson != "" {
+			var settings interface{}
+			if err := json.Unmarshal([]byte(extensionJson), &settings); err != nil {
+				return nil, fmt.Errorf("unmarshalling `extension_json` for extension %q: %+v", v["name"].(string), err)
+			}
+			extension.ExtensionSettings = &settings
+		}
+
+		results = append(results, extension)
+	}
+
+	return &results, nil
+}
+
+func expandDataCollectionRulePerformanceCounters(input []interface{}) *[]datacollectionrules.PerfCounterDataSource {
+	results := make([]datacollectionrules.PerfCounterDataSource, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, datacollectionrules.PerfCounterDataSource{
+			Name:                       utils.String(v["name"].(string)),
+			Streams:                    expandDataCollectionRuleStreams(v["streams"].(*pluginsdk.Set).List()),
+			SamplingFrequencyInSeconds: utils.Int64(int64(v["sampling_frequency_in_seconds"].(int))),
+			CounterSpecifiers:          utils.ExpandStringSlice(v["counter_specifiers"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func expandDataCollectionRuleSyslog(input []interface{}) *[]datacollectionrules.SyslogDataSource {
+	results := make([]datacollectionrules.SyslogDataSource, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		facilityNames := make([]datacollectionrules.KnownSyslogDataSourceFacilityNames, 0)
+		for _, facilityName := range v["facility_names"].(*pluginsdk.Set).List() {
+			facilityNames = append(facilityNames, datacollectionrules.KnownSyslogDataSourceFacilityNames(facilityName.(string)))
+		}
+
+		logLevels := make([]datacollectionrules.KnownSyslogDataSourceLogLevels, 0)
+		for _, logLevel := range v["log_levels"].(*pluginsdk.Set).List() {
+			logLevels = append(logLevels, datacollectionrules.KnownSyslogDataSourceLogLevels(logLevel.(string)))
+		}
+
+		results = append(results, datacollectionrules.SyslogDataSource{
+			Name:          utils.String(v["name"].(string)),
+			Streams:       expandDataCollectionRuleStreams(v["streams"].(*pluginsdk.Set).List()),
+			FacilityNames: &facilityNames,
+			LogLevels:     &logLevels,
+		})
+	}
+
+	return &results
+}
+
+func expandDataCollectionRuleWindowsEventLogs(input []interface{}) *[]datacollectionrules.WindowsEventLogDataSource {
+	results := make([]datacollectionrules.WindowsEventLogDataSource, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, datacollectionrules.WindowsEventLogDataSource{
+			Name:         utils.String(v["name"].(string)),
+			Streams:      expandDataCollectionRuleStreams(v["streams"].(*pluginsdk.Set).List()),
+			XPathQueries: utils.ExpandStringSlice(v["x_path_queries"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func expandDataCollectionRuleDestinations(input []interface{}) *datacollectionrules.Destinations {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	destinations := datacollectionrules.Destinations{}
+
+	if metrics := v["azure_monitor_metrics"].([]interface{}); len(metrics) > 0 && metrics[0] != nil {
+		metric := metrics[0].(map[string]interface{})
+		destinations.AzureMonitorMetrics = &datacollectionrules.AzureMonitorMetricsDestination{
+			Name: utils.String(metric["name"].(string)),
+		}
+	}
+
+	logAnalytics := make([]datacollectionrules.LogAnalyticsDestination, 0)
+	for _, item := range v["log_analytics"].([]interface{}) {
+		logAnalytic := item.(map[string]interface{})
+		logAnalytics = append(logAnalytics, datacollectionrules.LogAnalyticsDestination{
+			Name:                utils.String(logAnalytic["name"].(string)),
+			WorkspaceResourceId: utils.String(logAnalytic["workspace_resource_id"].(string)),
+		})
+	}
+	destinations.LogAnalytics = &logAnalytics
+
+	return &destinations
+}
+
+func expandDataCollectionRuleDataFlows(input []interface{}) *[]datacollectionrules.DataFlow {
+	results := make([]datacollectionrules.DataFlow, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, datacollectionrules.DataFlow{
+			Streams:      expandDataCollectionRuleStreams(v["streams"].(*pluginsdk.Set).List()),
+			Destinations: utils.ExpandStringSlice(v["destinations"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func flattenDataCollectionRuleDataSources(input *datacollectionrules.DataSources) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	extensions := make([]interface{}, 0)
+	if input.Extensions != nil {
+		for _, item := range *input.Extensions {
+			extensionJson := ""
+			if item.ExtensionSettings != nil {
+				settings, err := json.Marshal(*item.ExtensionSettings)
+				if err != nil {
+					return nil, fmt.Errorf("marshalling `extension_json`: %+v", err)
+				}
+				extensionJson = string(settings)
+			}
+
+			extensions = append(extensions, map[string]interface{}{
+				"name":               utils.NormalizeNilableString(item.Name),
+				"extension_name":     item.ExtensionName,
+				"streams":            flattenDataCollectionRuleStreams(item.Streams),
+				"extension_json":     extensionJson,
+				"input_data_sources": utils.FlattenStringSlice(item.InputDataSources),
+			})
+		}
+	}
+
+	performanceCounters := make([]interface{}, 0)
+	if input.PerformanceCounters != nil {
+		for _, item := range *input.PerformanceCounters {
+			samplingFrequencyInSeconds := 0
+			if item.SamplingFrequencyInSeconds != nil {
+				samplingFrequencyInSeconds = int(*item.SamplingFrequencyInSeconds)
+			}
+
+			performanceCounters = append(performanceCounters, map[string]interface{}{
+				"name":                          utils.NormalizeNilableString(item.Name),
+				"streams":                       flattenDataCollectionRuleStreams(item.Streams),
+				"sampling_frequency_in_seconds": samplingFrequencyInSeconds,
+				"counter_specifiers":            utils.FlattenStringSlice(item.CounterSpecifiers),
+			})
+		}
+	}
+
+	syslog := make([]interface{}, 0)
+	if input.Syslog != nil {
+		for _, item := range *input.Syslog {
+			facilityNames := make([]interface{}, 0)
+			if item.FacilityNames != nil {
+				for _, facilityName := range *item.FacilityNames {
+					facilityNames = append(facilityNames, string(facilityName))
+				}
+			}
+
+			logLevels := make([]interface{}, 0)
+			if item.LogLevels != nil {
+				for _, logLevel := range *item.LogLevels {
+					logLevels = append(logLevels, string(logLevel))
+				}
+			}
+
+			syslog = append(syslog, map[string]interface{}{
+				"name":           utils.NormalizeNilableString(item.Name),
+				"streams":        flattenDataCollectionRuleStreams(item.Streams),
+				"facility_names": facilityNames,
+				"log_levels":     logLevels,
+			})
+		}
+	}
+
+	windowsEventLogs := make([]interface{}, 0)
+	if input.WindowsEventLogs != nil {
+		for _, item := range *input.WindowsEventLogs {
+			windowsEventLogs = append(windowsEventLogs, map[string]interface{}{
+				"name":           utils.NormalizeNilableString(item.Name),
+				"streams":        flattenDataCollectionRuleStreams(item.Streams),
+				"x_path_queries": utils.FlattenStringSlice(item.XPathQueries),
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"extension":           extensions,
+			"performance_counter": performanceCounters,
+			"syslog":              syslog,
+			"windows_event_log":   windowsEventLogs,
+		},
+	}, nil
+}
+
+func flattenDataCollectionRuleDestinations(input *datacollectionrules.Destinations) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	azureMonitorMetrics := make([]interface{}, 0)
+	if input.AzureMonitorMetrics != nil {
+		azureMonitorMetrics = append(azureMonitorMetrics, map[string]interface{}{
+			"name": utils.NormalizeNilableString(input.AzureMonitorMetrics.Name),
+		})
+	}
+
+	logAnalytics := make([]interface{}, 0)
+	if input.LogAnalytics != nil {
+		for _, item := range *input.LogAnalytics {
+			logAnalytics = append(logAnalytics, map[string]interface{}{
+				"name":                  utils.NormalizeNilableString(item.Name),
+				"workspace_resource_id": utils.NormalizeNilableString(item.WorkspaceResourceId),
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"azure_monitor_metrics": azureMonitorMetrics,
+			"log_analytics":         logAnalytics,
+		},
+	}
+}
+
+func flattenDataCollectionRuleDataFlows(input *[]datacollectionrules.DataFlow) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"streams":      flattenDataCollectionRuleStreams(item.Streams),
+			"destinations": utils.FlattenStringSlice(item.Destinations),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,57 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+const dataCollectionRuleAssociationIdSeparator = "/providers/Microsoft.Insights/dataCollectionRuleAssociations/"
+
+type DataCollectionRuleAssociationId struct {
+	TargetResourceId string
+	Name             string
+}
+
+func NewDataCollectionRuleAssociationID(targetResourceId, name string) DataCollectionRuleAssociationId {
+	return DataCollectionRuleAssociationId{
+		TargetResourceId: targetResourceId,
+		Name:             name,
+	}
+}
+
+func (id DataCollectionRuleAssociationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Target Resource ID %q", id.TargetResourceId),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Data Collection Rule Association", segmentsStr)
+}
+
+func (id DataCollectionRuleAssociationId) ID() string {
+	fmtString := "%s" + dataCollectionRuleAssociationIdSeparator + "%s"
+	return fmt.Sprintf(fmtString, id.TargetResourceId, id.Name)
+}
+
+// DataCollectionRuleAssociationID parses a DataCollectionRuleAssociation ID into an DataCollectionRuleAssociationId struct
+func DataCollectionRuleAssociationID(input string) (*DataCollectionRuleAssociationId, error) {
+	parts := strings.Split(input, dataCollectionRuleAssociationIdSeparator)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid Data Collection Rule Association ID - ID should be in the format `{targetResourceId}%s{name}`: %q", dataCollectionRuleAssociationIdSeparator, input)
+	}
+
+	if _, err := azure.ParseAzureResourceID(parts[0]); err != nil {
+		return nil, fmt.Errorf("parsing Target Resource ID %q: %+v", parts[0], err)
+	}
+
+	if parts[1] == "" || strings.Contains(parts[1], "/") {
+		return nil, fmt.Errorf("ID was missing the 'dataCollectionRuleAssociations' element")
+	}
+
+	return &DataCollectionRuleAssociationId{
+		TargetResourceId: parts[0],
+		Name:             parts[1],
+	}, nil
+}
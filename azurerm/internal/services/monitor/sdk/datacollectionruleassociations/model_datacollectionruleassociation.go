@@ -0,0 +1,7 @@
+package datacollectionruleassociations
+
+type DataCollectionRuleAssociation struct {
+	DataCollectionRuleId *string `json:"dataCollectionRuleId,omitempty"`
+	Description          *string `json:"description,omitempty"`
+	ProvisioningState    *string `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,10 @@
+package datacollectionrules
+
+type DataCollectionRule struct {
+	DataFlows         *[]DataFlow   `json:"dataFlows,omitempty"`
+	DataSources       *DataSources  `json:"dataSources,omitempty"`
+	Description       *string       `json:"description,omitempty"`
+	Destinations      *Destinations `json:"destinations,omitempty"`
+	ImmutableId       *string       `json:"immutableId,omitempty"`
+	ProvisioningState *string       `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,6 @@
+package datacollectionrules
+
+type DataFlow struct {
+	Destinations *[]string               `json:"destinations,omitempty"`
+	Streams      *[]KnownDataFlowStreams `json:"streams,omitempty"`
+}
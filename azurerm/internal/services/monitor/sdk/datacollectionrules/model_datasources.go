@@ -0,0 +1,8 @@
+package datacollectionrules
+
+type DataSources struct {
+	Extensions          *[]ExtensionDataSource       `json:"extensions,omitempty"`
+	PerformanceCounters *[]PerfCounterDataSource     `json:"performanceCounters,omitempty"`
+	Syslog              *[]SyslogDataSource          `json:"syslog,omitempty"`
+	WindowsEventLogs    *[]WindowsEventLogDataSource `json:"windowsEventLogs,omitempty"`
+}
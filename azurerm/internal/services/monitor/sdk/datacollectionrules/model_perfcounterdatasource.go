@@ -0,0 +1,8 @@
+package datacollectionrules
+
+type PerfCounterDataSource struct {
+	CounterSpecifiers          *[]string               `json:"counterSpecifiers,omitempty"`
+	Name                       *string                 `json:"name,omitempty"`
+	SamplingFrequencyInSeconds *int64                  `json:"samplingFrequencyInSeconds,omitempty"`
+	Streams                    *[]KnownDataFlowStreams `json:"streams,omitempty"`
+}
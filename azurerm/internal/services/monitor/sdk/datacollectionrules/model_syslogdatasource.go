@@ -0,0 +1,8 @@
+package datacollectionrules
+
+type SyslogDataSource struct {
+	FacilityNames *[]KnownSyslogDataSourceFacilityNames `json:"facilityNames,omitempty"`
+	LogLevels     *[]KnownSyslogDataSourceLogLevels     `json:"logLevels,omitempty"`
+	Name          *string                               `json:"name,omitempty"`
+	Streams       *[]KnownDataFlowStreams               `json:"streams,omitempty"`
+}
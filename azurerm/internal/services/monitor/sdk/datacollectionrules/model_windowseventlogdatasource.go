@@ -0,0 +1,7 @@
+package datacollectionrules
+
+type WindowsEventLogDataSource struct {
+	Name         *string                 `json:"name,omitempty"`
+	Streams      *[]KnownDataFlowStreams `json:"streams,omitempty"`
+	XPathQueries *[]string               `json:"xPathQueries,omitempty"`
+}
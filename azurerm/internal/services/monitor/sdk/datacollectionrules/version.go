@@ -0,0 +1,9 @@
+package datacollectionrules
+
+import "fmt"
+
+const defaultApiVersion = "2021-04-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/datacollectionrules/%s", defaultApiVersion)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/fakearm"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
@@ -34,6 +35,48 @@ func TestAccAzureRMUserAssignedIdentity_basic(t *testing.T) {
 	})
 }
 
+func TestUserAssignedIdentity_lifecycleAgainstFakeServer(t *testing.T) {
+	server := fakearm.NewServer(t)
+
+	resourceGroup := server.Lifecycle(t, "azurerm_resource_group")
+	resourceGroup.Apply(map[string]interface{}{
+		"name":     "acctestRG-fake",
+		"location": "West Europe",
+	})
+
+	lifecycle := server.Lifecycle(t, "azurerm_user_assigned_identity")
+	config := map[string]interface{}{
+		"name":                "acctest-fake",
+		"resource_group_name": "acctestRG-fake",
+		"location":            "West Europe",
+	}
+	state := lifecycle.Apply(config)
+	expected := parse.NewUserAssignedIdentityID(fakearm.SubscriptionId, "acctestRG-fake", "acctest-fake").ID()
+	if state.ID != expected {
+		t.Fatalf("expected the ID to be %q but got %q", expected, state.ID)
+	}
+
+	config["tags"] = map[string]interface{}{
+		"environment": "Production",
+	}
+	state = lifecycle.Apply(config)
+	if v := state.Attributes["tags.environment"]; v != "Production" {
+		t.Fatalf("expected the tag `environment` to be %q but got %q", "Production", v)
+	}
+
+	lifecycle.Destroy()
+	if _, exists := server.Resource(expected); exists {
+		t.Fatalf("expected the User Assigned Identity to have been deleted")
+	}
+
+	// deleting the Resource Group should remove any Resources within it
+	lifecycle.Apply(config)
+	resourceGroup.Destroy()
+	if lifecycle.Refresh() != nil {
+		t.Fatalf("expected the User Assigned Identity to have been deleted with the Resource Group")
+	}
+}
+
 func TestAccAzureRMUserAssignedIdentity_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_user_assigned_identity", "test")
 	r := UserAssignedIdentityResource{}
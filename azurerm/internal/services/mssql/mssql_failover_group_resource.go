@@ -0,0 +1,527 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceMsSqlFailoverGroup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMsSqlFailoverGroupCreate,
+		Read:   resourceMsSqlFailoverGroupRead,
+		Update: resourceMsSqlFailoverGroupUpdate,
+		Delete: resourceMsSqlFailoverGroupDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FailoverGroupID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateMsSqlFailoverGroupName,
+			},
+
+			// this isn't ForceNew since swapping this with the partner server triggers a failover, see the CustomizeDiff
+			"server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ServerID,
+			},
+
+			"partner_server": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ServerID,
+						},
+
+						"location": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"role": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"databases": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.DatabaseID,
+				},
+			},
+
+			"read_write_endpoint_failover_policy": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.Automatic),
+								string(sql.Manual),
+							}, false),
+						},
+
+						"grace_minutes": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+
+			"readonly_endpoint_failover_policy_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"role": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			msSqlFailoverGroupPrimaryServerDiff,
+			msSqlFailoverGroupReadWritePolicyDiff,
+		),
+	}
+}
+
+func resourceMsSqlFailoverGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.FailoverGroupsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	serverId, err := parse.ServerID(d.Get("server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFailoverGroupID(serverId.SubscriptionId, serverId.ResourceGroup, serverId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_mssql_failover_group", id.ID())
+	}
+
+	parameters := sql.FailoverGroup{
+		FailoverGroupProperties: &sql.FailoverGroupProperties{
+			ReadWriteEndpoint: expandMsSqlFailoverGroupReadWritePolicy(d.Get("read_write_endpoint_failover_policy").([]interface{})),
+			ReadOnlyEndpoint:  expandMsSqlFailoverGroupReadOnlyPolicy(d.Get("readonly_endpoint_failover_policy_enabled").(bool)),
+			PartnerServers:    expandMsSqlFailoverGroupPartnerServers(d.Get("partner_server").([]interface{})),
+			Databases:         utils.ExpandStringSlice(d.Get("databases").(*pluginsdk.Set).List()),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMsSqlFailoverGroupRead(d, meta)
+}
+
+func resourceMsSqlFailoverGroupUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.FailoverGroupsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FailoverGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("server_id") {
+		// the designated primary has been swapped with the partner server, so we perform a planned
+		// failover, which is issued against the (current) secondary server and doesn't lose any data
+		serverId, err := parse.ServerID(d.Get("server_id").(string))
+		if err != nil {
+			return err
+		}
+
+		newId := parse.NewFailoverGroupID(serverId.SubscriptionId, serverId.ResourceGroup, serverId.Name, id.Name)
+		log.Printf("[DEBUG] Failing over %s to %q..", *id, serverId.Name)
+
+		future, err := client.Failover(ctx, newId.ResourceGroup, newId.ServerName, newId.Name)
+		if err != nil {
+			return fmt.Errorf("failing over %s: %+v", newId, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for failover of %s: %+v", newId, err)
+		}
+
+		log.Printf("[DEBUG] Failed over %s to %q.", *id, serverId.Name)
+		id = &newId
+		d.SetId(id.ID())
+	}
+
+	parameters := sql.FailoverGroupUpdate{
+		FailoverGroupUpdateProperties: &sql.FailoverGroupUpdateProperties{
+			ReadWriteEndpoint: expandMsSqlFailoverGroupReadWritePolicy(d.Get("read_write_endpoint_failover_policy").([]interface{})),
+			ReadOnlyEndpoint:  expandMsSqlFailoverGroupReadOnlyPolicy(d.Get("readonly_endpoint_failover_policy_enabled").(bool)),
+			Databases:         utils.ExpandStringSlice(d.Get("databases").(*pluginsdk.Set).List()),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ServerName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return resourceMsSqlFailoverGroupRead(d, meta)
+}
+
+func resourceMsSqlFailoverGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.FailoverGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FailoverGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	// when the Failover Group has failed over outside of Terraform this Server is now the Secondary, in which
+	// case the Failover Group is read from the (new) Primary Server instead - such that this is shown in the diff
+	if props := resp.FailoverGroupProperties; props != nil && props.ReplicationRole == sql.Secondary {
+		if primaryId := msSqlFailoverGroupPrimaryServerId(props.PartnerServers); primaryId != "" {
+			primaryServerId, err := parse.ServerID(primaryId)
+			if err != nil {
+				return err
+			}
+
+			primaryFailoverGroupId := parse.NewFailoverGroupID(primaryServerId.SubscriptionId, primaryServerId.ResourceGroup, primaryServerId.Name, id.Name)
+			log.Printf("[DEBUG] %s is now the Secondary - reading %s instead", id, primaryFailoverGroupId)
+
+			resp, err = client.Get(ctx, primaryFailoverGroupId.ResourceGroup, primaryFailoverGroupId.ServerName, primaryFailoverGroupId.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", primaryFailoverGroupId, err)
+			}
+
+			id = &primaryFailoverGroupId
+			d.SetId(id.ID())
+		}
+	}
+
+	d.Set("name", id.Name)
+	d.Set("server_id", parse.NewServerID(id.SubscriptionId, id.ResourceGroup, id.ServerName).ID())
+
+	if props := resp.FailoverGroupProperties; props != nil {
+		d.Set("role", string(props.ReplicationRole))
+
+		if err := d.Set("partner_server", flattenMsSqlFailoverGroupPartnerServers(props.PartnerServers)); err != nil {
+			return fmt.Errorf("setting `partner_server`: %+v", err)
+		}
+
+		if err := d.Set("read_write_endpoint_failover_policy", flattenMsSqlFailoverGroupReadWritePolicy(props.ReadWriteEndpoint)); err != nil {
+			return fmt.Errorf("setting `read_write_endpoint_failover_policy`: %+v", err)
+		}
+
+		readOnlyEnabled := false
+		if props.ReadOnlyEndpoint != nil {
+			readOnlyEnabled = props.ReadOnlyEndpoint.FailoverPolicy == sql.ReadOnlyEndpointFailoverPolicyEnabled
+		}
+		d.Set("readonly_endpoint_failover_policy_enabled", readOnlyEnabled)
+
+		if err := d.Set("databases", utils.FlattenStringSlice(props.Databases)); err != nil {
+			return fmt.Errorf("setting `databases`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceMsSqlFailoverGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.FailoverGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FailoverGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// msSqlFailoverGroupPrimaryServerDiff only allows `server_id` and `partner_server` to be updated in-place when
+// these are being swapped with one another (with a single partner server), since that's a failover - any other
+// change to either requires a new Failover Group
+func msSqlFailoverGroupPrimaryServerDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	oldServerRaw, newServerRaw := d.GetChange("server_id")
+	oldPartnersRaw, newPartnersRaw := d.GetChange("partner_server")
+	oldPartnerIds := msSqlFailoverGroupPartnerServerIds(oldPartnersRaw.([]interface{}))
+	newPartnerIds := msSqlFailoverGroupPartnerServerIds(newPartnersRaw.([]interface{}))
+
+	serverChanged := !strings.EqualFold(oldServerRaw.(string), newServerRaw.(string))
+	partnersChanged := len(oldPartnerIds) != len(newPartnerIds)
+	for i := 0; !partnersChanged && i < len(oldPartnerIds); i++ {
+		partnersChanged = !strings.EqualFold(oldPartnerIds[i], newPartnerIds[i])
+	}
+
+	if !serverChanged && !partnersChanged {
+		return nil
+	}
+
+	if len(oldPartnerIds) == 1 && len(newPartnerIds) == 1 {
+		if strings.EqualFold(oldPartnerIds[0], newServerRaw.(string)) && strings.EqualFold(newPartnerIds[0], oldServerRaw.(string)) {
+			return msSqlFailoverGroupDatabasesDiff(d, newServerRaw.(string))
+		}
+	}
+
+	if serverChanged {
+		if err := d.ForceNew("server_id"); err != nil {
+			return err
+		}
+	}
+	if partnersChanged {
+		if err := d.ForceNew("partner_server"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// msSqlFailoverGroupDatabasesDiff validates that the `databases` are on the (new) Primary Server when this is
+// being swapped with the Partner Server, since the Databases within the Failover Group are those on the Primary
+func msSqlFailoverGroupDatabasesDiff(d *pluginsdk.ResourceDiff, serverId string) error {
+	if !d.NewValueKnown("databases") {
+		return nil
+	}
+
+	for _, raw := range d.Get("databases").(*pluginsdk.Set).List() {
+		databaseId, err := parse.DatabaseID(raw.(string))
+		if err != nil {
+			return err
+		}
+
+		databaseServerId := parse.NewServerID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ServerName)
+		if !strings.EqualFold(databaseServerId.ID(), serverId) {
+			return fmt.Errorf("the Database %q must be on the Primary Server %q when failing over - the `databases` should be updated to the Databases on the Server %q", raw.(string), serverId, serverId)
+		}
+	}
+
+	return nil
+}
+
+func msSqlFailoverGroupPartnerServerIds(input []interface{}) []string {
+	results := make([]string, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		results = append(results, item.(map[string]interface{})["id"].(string))
+	}
+
+	return results
+}
+
+// msSqlFailoverGroupReadWritePolicyDiff validates that `grace_minutes` is only specified (and is required)
+// when the `mode` of the Read/Write Endpoint Failover Policy is `Automatic`
+func msSqlFailoverGroupReadWritePolicyDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("read_write_endpoint_failover_policy.0.mode") || !d.NewValueKnown("read_write_endpoint_failover_policy.0.grace_minutes") {
+		return nil
+	}
+
+	mode := sql.ReadWriteEndpointFailoverPolicy(d.Get("read_write_endpoint_failover_policy.0.mode").(string))
+	graceMinutes := d.Get("read_write_endpoint_failover_policy.0.grace_minutes").(int)
+
+	if mode == sql.Automatic && graceMinutes == 0 {
+		return fmt.Errorf("`grace_minutes` must be specified when `mode` is `%s`", string(sql.Automatic))
+	}
+	if mode == sql.Manual && graceMinutes != 0 {
+		return fmt.Errorf("`grace_minutes` can only be specified when `mode` is `%s`", string(sql.Automatic))
+	}
+
+	return nil
+}
+
+func expandMsSqlFailoverGroupReadWritePolicy(input []interface{}) *sql.FailoverGroupReadWriteEndpoint {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	policy := input[0].(map[string]interface{})
+	mode := sql.ReadWriteEndpointFailoverPolicy(policy["mode"].(string))
+
+	result := sql.FailoverGroupReadWriteEndpoint{
+		FailoverPolicy: mode,
+	}
+
+	if mode == sql.Automatic {
+		result.FailoverWithDataLossGracePeriodMinutes = utils.Int32(int32(policy["grace_minutes"].(int)))
+	}
+
+	return &result
+}
+
+func flattenMsSqlFailoverGroupReadWritePolicy(input *sql.FailoverGroupReadWriteEndpoint) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	graceMinutes := 0
+	if input.FailoverWithDataLossGracePeriodMinutes != nil {
+		graceMinutes = int(*input.FailoverWithDataLossGracePeriodMinutes)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":          string(input.FailoverPolicy),
+			"grace_minutes": graceMinutes,
+		},
+	}
+}
+
+func expandMsSqlFailoverGroupReadOnlyPolicy(enabled bool) *sql.FailoverGroupReadOnlyEndpoint {
+	policy := sql.ReadOnlyEndpointFailoverPolicyDisabled
+	if enabled {
+		policy = sql.ReadOnlyEndpointFailoverPolicyEnabled
+	}
+
+	return &sql.FailoverGroupReadOnlyEndpoint{
+		FailoverPolicy: policy,
+	}
+}
+
+func expandMsSqlFailoverGroupPartnerServers(input []interface{}) *[]sql.PartnerInfo {
+	results := make([]sql.PartnerInfo, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		partner := item.(map[string]interface{})
+		results = append(results, sql.PartnerInfo{
+			ID: utils.String(partner["id"].(string)),
+		})
+	}
+
+	return &results
+}
+
+// msSqlFailoverGroupPrimaryServerId returns the ID of the Partner Server which is the Primary for the Failover Group
+func msSqlFailoverGroupPrimaryServerId(input *[]sql.PartnerInfo) string {
+	if input == nil {
+		return ""
+	}
+
+	for _, partner := range *input {
+		if partner.ReplicationRole == sql.Primary && partner.ID != nil {
+			return *partner.ID
+		}
+	}
+
+	return ""
+}
+
+func flattenMsSqlFailoverGroupPartnerServers(input *[]sql.PartnerInfo) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, partner := range *input {
+		partnerId := ""
+		if partner.ID != nil {
+			partnerId = *partner.ID
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":       partnerId,
+			"location": location.NormalizeNilable(partner.Location),
+			"role":     string(partner.ReplicationRole),
+		})
+	}
+
+	return results
+}
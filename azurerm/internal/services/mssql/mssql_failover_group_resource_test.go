@@ -0,0 +1,382 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type MsSqlFailoverGroupResource struct{}
+
+func TestAccMsSqlFailoverGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group", "test")
+	r := MsSqlFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+				check.That(data.ResourceName).Key("partner_server.0.role").HasValue("Secondary"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlFailoverGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group", "test")
+	r := MsSqlFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlFailoverGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group", "test")
+	r := MsSqlFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("databases.#").HasValue("1"),
+				check.That(data.ResourceName).Key("readonly_endpoint_failover_policy_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("databases.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlFailoverGroup_failover(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group", "test")
+	r := MsSqlFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.failover(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+				check.That(data.ResourceName).Key("server_id").MatchesOtherKey(
+					check.That("azurerm_mssql_server.secondary").Key("id"),
+				),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlFailoverGroup_failoverOutsideOfTerraform(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group", "test")
+	r := MsSqlFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.failoverToPartner),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			// the out-of-band failover is reverted
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+				check.That(data.ResourceName).Key("server_id").MatchesOtherKey(
+					check.That("azurerm_mssql_server.test").Key("id"),
+				),
+				check.That(data.ResourceName).Key("id").MatchesRegex(regexp.MustCompile(fmt.Sprintf("/servers/acctestmssql%d/failoverGroups/", data.RandomInteger))),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlFailoverGroup_failoverOutsideOfTerraformRetained(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group", "test")
+	r := MsSqlFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.failoverToPartner),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			// the configuration is updated to match the out-of-band failover, so the ID now refers to the Failover Group on the new Primary
+			Config: r.failover(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+				check.That(data.ResourceName).Key("server_id").MatchesOtherKey(
+					check.That("azurerm_mssql_server.secondary").Key("id"),
+				),
+				check.That(data.ResourceName).Key("id").MatchesRegex(regexp.MustCompile(fmt.Sprintf("/servers/acctestmssql2%d/failoverGroups/", data.RandomInteger))),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlFailoverGroup_failoverWithDatabasesOnPreviousPrimary(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group", "test")
+	r := MsSqlFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.failoverWithDatabasesOnPreviousPrimary(data),
+			ExpectError: regexp.MustCompile("must be on the Primary Server"),
+		},
+	})
+}
+
+func (MsSqlFailoverGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FailoverGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.FailoverGroupsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (MsSqlFailoverGroupResource) failoverToPartner(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+	id, err := parse.FailoverGroupID(state.ID)
+	if err != nil {
+		return err
+	}
+
+	partnerId, err := parse.ServerID(state.Attributes["partner_server.0.id"])
+	if err != nil {
+		return err
+	}
+
+	future, err := client.MSSQL.FailoverGroupsClient.Failover(ctx, partnerId.ResourceGroup, partnerId.Name, id.Name)
+	if err != nil {
+		return fmt.Errorf("failing over %s to %s: %+v", id, partnerId, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.MSSQL.FailoverGroupsClient.Client); err != nil {
+		return fmt.Errorf("waiting for failover of %s to %s: %+v", id, partnerId, err)
+	}
+
+	return nil
+}
+
+func (r MsSqlFailoverGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_failover_group" "test" {
+  name      = "acctestfg%d"
+  server_id = azurerm_mssql_server.test.id
+
+  partner_server {
+    id = azurerm_mssql_server.secondary.id
+  }
+
+  read_write_endpoint_failover_policy {
+    mode          = "Automatic"
+    grace_minutes = 60
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlFailoverGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_failover_group" "import" {
+  name      = azurerm_mssql_failover_group.test.name
+  server_id = azurerm_mssql_failover_group.test.server_id
+
+  partner_server {
+    id = azurerm_mssql_failover_group.test.partner_server.0.id
+  }
+
+  read_write_endpoint_failover_policy {
+    mode          = "Automatic"
+    grace_minutes = 60
+  }
+}
+`, r.basic(data))
+}
+
+func (r MsSqlFailoverGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_failover_group" "test" {
+  name      = "acctestfg%d"
+  server_id = azurerm_mssql_server.test.id
+  databases = [azurerm_mssql_database.test.id]
+
+  partner_server {
+    id = azurerm_mssql_server.secondary.id
+  }
+
+  read_write_endpoint_failover_policy {
+    mode = "Manual"
+  }
+
+  readonly_endpoint_failover_policy_enabled = true
+
+  tags = {
+    environment = "prod"
+    database    = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlFailoverGroupResource) failover(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_failover_group" "test" {
+  name      = "acctestfg%d"
+  server_id = azurerm_mssql_server.secondary.id
+  databases = ["${azurerm_mssql_server.secondary.id}/databases/${azurerm_mssql_database.test.name}"]
+
+  partner_server {
+    id = azurerm_mssql_server.test.id
+  }
+
+  read_write_endpoint_failover_policy {
+    mode = "Manual"
+  }
+
+  readonly_endpoint_failover_policy_enabled = true
+
+  tags = {
+    environment = "prod"
+    database    = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlFailoverGroupResource) failoverWithDatabasesOnPreviousPrimary(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_failover_group" "test" {
+  name      = "acctestfg%d"
+  server_id = azurerm_mssql_server.secondary.id
+  databases = [azurerm_mssql_database.test.id]
+
+  partner_server {
+    id = azurerm_mssql_server.test.id
+  }
+
+  read_write_endpoint_failover_policy {
+    mode = "Manual"
+  }
+
+  readonly_endpoint_failover_policy_enabled = true
+
+  tags = {
+    environment = "prod"
+    database    = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (MsSqlFailoverGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctestmssql%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "missadministrator"
+  administrator_login_password = "thisIsKat11"
+}
+
+resource "azurerm_mssql_server" "secondary" {
+  name                         = "acctestmssql2%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = "%[3]s"
+  version                      = "12.0"
+  administrator_login          = "missadministrator"
+  administrator_login_password = "thisIsKat11"
+}
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctestdb%[1]d"
+  server_id = azurerm_mssql_server.test.id
+  sku_name  = "S1"
+}
+`, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
+}
@@ -0,0 +1,190 @@
+package mssql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceMsSqlManagedInstance() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceMsSqlManagedInstanceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateMsSqlManagedInstanceName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"location": azure.SchemaLocationForDataSource(),
+
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"administrator_login": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"subnet_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"license_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"vcores": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"storage_size_in_gb": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"collation": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"identity": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"principal_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"minimum_tls_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"proxy_override": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"public_data_endpoint_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"storage_account_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"timezone_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"dns_zone": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"fqdn": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func dataSourceMsSqlManagedInstanceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.ManagedInstancesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewManagedInstanceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	skuName := ""
+	if resp.Sku != nil && resp.Sku.Name != nil {
+		skuName = *resp.Sku.Name
+	}
+	d.Set("sku_name", skuName)
+
+	if err := d.Set("identity", flattenSqlServerIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	if props := resp.ManagedInstanceProperties; props != nil {
+		d.Set("administrator_login", props.AdministratorLogin)
+		d.Set("collation", props.Collation)
+		d.Set("dns_zone", props.DNSZone)
+		d.Set("fqdn", props.FullyQualifiedDomainName)
+		d.Set("license_type", string(props.LicenseType))
+		d.Set("minimum_tls_version", props.MinimalTLSVersion)
+		d.Set("proxy_override", string(props.ProxyOverride))
+		d.Set("public_data_endpoint_enabled", props.PublicDataEndpointEnabled)
+		d.Set("storage_account_type", string(props.StorageAccountType))
+		d.Set("subnet_id", props.SubnetID)
+		d.Set("timezone_id", props.TimezoneID)
+
+		storageSizeInGb := 0
+		if props.StorageSizeInGB != nil {
+			storageSizeInGb = int(*props.StorageSizeInGB)
+		}
+		d.Set("storage_size_in_gb", storageSizeInGb)
+
+		vCores := 0
+		if props.VCores != nil {
+			vCores = int(*props.VCores)
+		}
+		d.Set("vcores", vCores)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
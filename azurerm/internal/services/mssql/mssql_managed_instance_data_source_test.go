@@ -0,0 +1,39 @@
+package mssql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type MsSqlManagedInstanceDataSource struct{}
+
+func TestAccDataSourceMsSqlManagedInstance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mssql_managed_instance", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: MsSqlManagedInstanceDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sku_name").HasValue("GP_Gen5"),
+				check.That(data.ResourceName).Key("vcores").HasValue("4"),
+				check.That(data.ResourceName).Key("storage_size_in_gb").HasValue("32"),
+				check.That(data.ResourceName).Key("subnet_id").Exists(),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+			),
+		},
+	})
+}
+
+func (MsSqlManagedInstanceDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mssql_managed_instance" "test" {
+  name                = azurerm_mssql_managed_instance.test.name
+  resource_group_name = azurerm_mssql_managed_instance.test.resource_group_name
+}
+`, MsSqlManagedInstanceResource{}.basic(data))
+}
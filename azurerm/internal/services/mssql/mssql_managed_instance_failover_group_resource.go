@@ -0,0 +1,377 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceMsSqlManagedInstanceFailoverGroup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMsSqlManagedInstanceFailoverGroupCreateUpdate,
+		Read:   resourceMsSqlManagedInstanceFailoverGroupRead,
+		Update: resourceMsSqlManagedInstanceFailoverGroupCreateUpdate,
+		Delete: resourceMsSqlManagedInstanceFailoverGroupDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.InstanceFailoverGroupID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(msSqlManagedInstanceFailoverGroupReadWritePolicyDiff),
+
+		// the initial seeding of the databases into the partner Managed Instance can take several hours
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(24 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(24 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(24 * time.Hour),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateMsSqlFailoverGroupName,
+			},
+
+			"managed_instance_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedInstanceID,
+			},
+
+			"partner_managed_instance_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedInstanceID,
+			},
+
+			"read_write_endpoint_failover_policy": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.Automatic),
+								string(sql.Manual),
+							}, false),
+						},
+
+						"grace_minutes": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+
+			"readonly_endpoint_failover_policy_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"location": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"partner_region": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"location": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"role": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"role": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMsSqlManagedInstanceFailoverGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.InstanceFailoverGroupsClient
+	instancesClient := meta.(*clients.Client).MSSQL.ManagedInstancesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	instanceId, err := parse.ManagedInstanceID(d.Get("managed_instance_id").(string))
+	if err != nil {
+		return err
+	}
+
+	partnerInstanceId, err := parse.ManagedInstanceID(d.Get("partner_managed_instance_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// Instance Failover Groups are scoped to the location of the primary Managed Instance
+	instance, err := instancesClient.Get(ctx, instanceId.ResourceGroup, instanceId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *instanceId, err)
+	}
+	if instance.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", *instanceId)
+	}
+
+	partnerInstance, err := instancesClient.Get(ctx, partnerInstanceId.ResourceGroup, partnerInstanceId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving partner %s: %+v", *partnerInstanceId, err)
+	}
+	if partnerInstance.Location == nil {
+		return fmt.Errorf("retrieving partner %s: `location` was nil", *partnerInstanceId)
+	}
+
+	id := parse.NewInstanceFailoverGroupID(instanceId.SubscriptionId, instanceId.ResourceGroup, location.Normalize(*instance.Location), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.LocationName, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_mssql_managed_instance_failover_group", id.ID())
+		}
+	}
+
+	readOnlyFailoverPolicy := sql.ReadOnlyEndpointFailoverPolicyDisabled
+	if d.Get("readonly_endpoint_failover_policy_enabled").(bool) {
+		readOnlyFailoverPolicy = sql.ReadOnlyEndpointFailoverPolicyEnabled
+	}
+
+	parameters := sql.InstanceFailoverGroup{
+		InstanceFailoverGroupProperties: &sql.InstanceFailoverGroupProperties{
+			ReadOnlyEndpoint: &sql.InstanceFailoverGroupReadOnlyEndpoint{
+				FailoverPolicy: readOnlyFailoverPolicy,
+			},
+			ReadWriteEndpoint: expandMsSqlManagedInstanceFailoverGroupReadWritePolicy(d.Get("read_write_endpoint_failover_policy").([]interface{})),
+			PartnerRegions: &[]sql.PartnerRegionInfo{
+				{
+					Location: partnerInstance.Location,
+				},
+			},
+			ManagedInstancePairs: &[]sql.ManagedInstancePairInfo{
+				{
+					PrimaryManagedInstanceID: utils.String(instanceId.ID()),
+					PartnerManagedInstanceID: utils.String(partnerInstanceId.ID()),
+				},
+			},
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LocationName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMsSqlManagedInstanceFailoverGroupRead(d, meta)
+}
+
+func resourceMsSqlManagedInstanceFailoverGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.InstanceFailoverGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.InstanceFailoverGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.LocationName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("location", location.Normalize(id.LocationName))
+
+	if props := resp.InstanceFailoverGroupProperties; props != nil {
+		d.Set("role", string(props.ReplicationRole))
+
+		if err := d.Set("read_write_endpoint_failover_policy", flattenMsSqlManagedInstanceFailoverGroupReadWritePolicy(props.ReadWriteEndpoint)); err != nil {
+			return fmt.Errorf("setting `read_write_endpoint_failover_policy`: %+v", err)
+		}
+
+		readOnlyEnabled := false
+		if props.ReadOnlyEndpoint != nil {
+			readOnlyEnabled = props.ReadOnlyEndpoint.FailoverPolicy == sql.ReadOnlyEndpointFailoverPolicyEnabled
+		}
+		d.Set("readonly_endpoint_failover_policy_enabled", readOnlyEnabled)
+
+		if err := d.Set("partner_region", flattenMsSqlManagedInstanceFailoverGroupPartnerRegions(props.PartnerRegions)); err != nil {
+			return fmt.Errorf("setting `partner_region`: %+v", err)
+		}
+
+		instanceId := ""
+		partnerInstanceId := ""
+		if pairs := props.ManagedInstancePairs; pairs != nil && len(*pairs) > 0 {
+			pair := (*pairs)[0]
+			if pair.PrimaryManagedInstanceID != nil {
+				v, err := parse.ManagedInstanceIDInsensitively(*pair.PrimaryManagedInstanceID)
+				if err != nil {
+					return err
+				}
+				instanceId = v.ID()
+			}
+			if pair.PartnerManagedInstanceID != nil {
+				v, err := parse.ManagedInstanceIDInsensitively(*pair.PartnerManagedInstanceID)
+				if err != nil {
+					return err
+				}
+				partnerInstanceId = v.ID()
+			}
+		}
+
+		// the Primary and Partner are swapped around once a failover has occurred, so
+		// these are reported in the order of the Managed Instance the group belongs to
+		if props.ReplicationRole == sql.InstanceFailoverGroupReplicationRoleSecondary {
+			instanceId, partnerInstanceId = partnerInstanceId, instanceId
+		}
+		d.Set("managed_instance_id", instanceId)
+		d.Set("partner_managed_instance_id", partnerInstanceId)
+	}
+
+	return nil
+}
+
+func resourceMsSqlManagedInstanceFailoverGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.InstanceFailoverGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.InstanceFailoverGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.LocationName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// msSqlManagedInstanceFailoverGroupReadWritePolicyDiff validates that `grace_minutes` is only specified (and
+// is required) when the `mode` of the Read/Write Endpoint Failover Policy is `Automatic`
+func msSqlManagedInstanceFailoverGroupReadWritePolicyDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("read_write_endpoint_failover_policy.0.mode") || !d.NewValueKnown("read_write_endpoint_failover_policy.0.grace_minutes") {
+		return nil
+	}
+
+	mode := sql.ReadWriteEndpointFailoverPolicy(d.Get("read_write_endpoint_failover_policy.0.mode").(string))
+	graceMinutes := d.Get("read_write_endpoint_failover_policy.0.grace_minutes").(int)
+
+	if mode == sql.Automatic && graceMinutes == 0 {
+		return fmt.Errorf("`grace_minutes` must be specified when `mode` is `%s`", string(sql.Automatic))
+	}
+	if mode == sql.Manual && graceMinutes != 0 {
+		return fmt.Errorf("`grace_minutes` can only be specified when `mode` is `%s`", string(sql.Automatic))
+	}
+
+	return nil
+}
+
+func expandMsSqlManagedInstanceFailoverGroupReadWritePolicy(input []interface{}) *sql.InstanceFailoverGroupReadWriteEndpoint {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	policy := input[0].(map[string]interface{})
+	mode := sql.ReadWriteEndpointFailoverPolicy(policy["mode"].(string))
+
+	result := sql.InstanceFailoverGroupReadWriteEndpoint{
+		FailoverPolicy: mode,
+	}
+
+	if mode == sql.Automatic {
+		result.FailoverWithDataLossGracePeriodMinutes = utils.Int32(int32(policy["grace_minutes"].(int)))
+	}
+
+	return &result
+}
+
+func flattenMsSqlManagedInstanceFailoverGroupReadWritePolicy(input *sql.InstanceFailoverGroupReadWriteEndpoint) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	graceMinutes := 0
+	if input.FailoverWithDataLossGracePeriodMinutes != nil {
+		graceMinutes = int(*input.FailoverWithDataLossGracePeriodMinutes)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":          string(input.FailoverPolicy),
+			"grace_minutes": graceMinutes,
+		},
+	}
+}
+
+func flattenMsSqlManagedInstanceFailoverGroupPartnerRegions(input *[]sql.PartnerRegionInfo) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, region := range *input {
+		results = append(results, map[string]interface{}{
+			"location": location.NormalizeNilable(region.Location),
+			"role":     string(region.ReplicationRole),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,220 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type MsSqlManagedInstanceFailoverGroupResource struct{}
+
+func TestAccMsSqlManagedInstanceFailoverGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_failover_group", "test")
+	r := MsSqlManagedInstanceFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("read_write_endpoint_failover_policy.0.mode").HasValue("Manual"),
+				check.That(data.ResourceName).Key("readonly_endpoint_failover_policy_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlManagedInstanceFailoverGroup_gracePeriodWithManualMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_failover_group", "test")
+	r := MsSqlManagedInstanceFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.gracePeriodWithManualMode(data),
+			ExpectError: regexp.MustCompile("`grace_minutes` can only be specified when `mode` is `Automatic`"),
+		},
+	})
+}
+
+func (MsSqlManagedInstanceFailoverGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.InstanceFailoverGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.InstanceFailoverGroupsClient.Get(ctx, id.ResourceGroup, id.LocationName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_failover_group" "test" {
+  name                        = "acctest-fog-%d"
+  managed_instance_id         = azurerm_mssql_managed_instance.test.id
+  partner_managed_instance_id = azurerm_mssql_managed_instance.secondary.id
+
+  read_write_endpoint_failover_policy {
+    mode          = "Automatic"
+    grace_minutes = 60
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_failover_group" "test" {
+  name                        = "acctest-fog-%d"
+  managed_instance_id         = azurerm_mssql_managed_instance.test.id
+  partner_managed_instance_id = azurerm_mssql_managed_instance.secondary.id
+
+  readonly_endpoint_failover_policy_enabled = false
+
+  read_write_endpoint_failover_policy {
+    mode = "Manual"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) gracePeriodWithManualMode(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_failover_group" "test" {
+  name                        = "acctest-fog-%d"
+  managed_instance_id         = azurerm_mssql_managed_instance.test.id
+  partner_managed_instance_id = azurerm_mssql_managed_instance.secondary.id
+
+  read_write_endpoint_failover_policy {
+    mode          = "Manual"
+    grace_minutes = 60
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (MsSqlManagedInstanceFailoverGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_resource_group" "secondary" {
+  name     = "acctestRG-sqlmi2-%[2]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_virtual_network" "secondary" {
+  name                = "acctest-vnet2-%[2]d"
+  resource_group_name = azurerm_resource_group.secondary.name
+  address_space       = ["10.1.0.0/16"]
+  location            = azurerm_resource_group.secondary.location
+}
+
+resource "azurerm_subnet" "secondary" {
+  name                 = "subnet2-%[2]d"
+  resource_group_name  = azurerm_resource_group.secondary.name
+  virtual_network_name = azurerm_virtual_network.secondary.name
+  address_prefixes     = ["10.1.0.0/24"]
+
+  delegation {
+    name = "managedinstancedelegation"
+
+    service_delegation {
+      name = "Microsoft.Sql/managedInstances"
+      actions = [
+        "Microsoft.Network/virtualNetworks/subnets/join/action",
+        "Microsoft.Network/virtualNetworks/subnets/prepareNetworkPolicies/action",
+        "Microsoft.Network/virtualNetworks/subnets/unprepareNetworkPolicies/action",
+      ]
+    }
+  }
+}
+
+resource "azurerm_network_security_group" "secondary" {
+  name                = "acctest-nsg2-%[2]d"
+  location            = azurerm_resource_group.secondary.location
+  resource_group_name = azurerm_resource_group.secondary.name
+}
+
+resource "azurerm_subnet_network_security_group_association" "secondary" {
+  subnet_id                 = azurerm_subnet.secondary.id
+  network_security_group_id = azurerm_network_security_group.secondary.id
+}
+
+resource "azurerm_route_table" "secondary" {
+  name                          = "acctest-rt2-%[2]d"
+  location                      = azurerm_resource_group.secondary.location
+  resource_group_name           = azurerm_resource_group.secondary.name
+  disable_bgp_route_propagation = false
+}
+
+resource "azurerm_subnet_route_table_association" "secondary" {
+  subnet_id      = azurerm_subnet.secondary.id
+  route_table_id = azurerm_route_table.secondary.id
+}
+
+resource "azurerm_virtual_network_peering" "primary" {
+  name                      = "primary-to-secondary"
+  resource_group_name       = azurerm_resource_group.test.name
+  virtual_network_name      = azurerm_virtual_network.test.name
+  remote_virtual_network_id = azurerm_virtual_network.secondary.id
+}
+
+resource "azurerm_virtual_network_peering" "secondary" {
+  name                      = "secondary-to-primary"
+  resource_group_name       = azurerm_resource_group.secondary.name
+  virtual_network_name      = azurerm_virtual_network.secondary.name
+  remote_virtual_network_id = azurerm_virtual_network.test.id
+}
+
+resource "azurerm_mssql_managed_instance" "secondary" {
+  name                = "acctestsqlmi2%[2]d"
+  resource_group_name = azurerm_resource_group.secondary.name
+  location            = azurerm_resource_group.secondary.location
+
+  license_type       = "BasePrice"
+  sku_name           = "GP_Gen5"
+  storage_size_in_gb = 32
+  subnet_id          = azurerm_subnet.secondary.id
+  vcores             = 4
+
+  administrator_login          = "missadministrator"
+  administrator_login_password = "NCC-1701-D"
+
+  dns_zone_partner_id = azurerm_mssql_managed_instance.test.id
+
+  depends_on = [
+    azurerm_subnet_network_security_group_association.secondary,
+    azurerm_subnet_route_table_association.secondary,
+  ]
+}
+`, MsSqlManagedInstanceResource{}.basic(data), data.RandomInteger, data.Locations.Secondary)
+}
@@ -0,0 +1,437 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
+	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceMsSqlManagedInstance() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMsSqlManagedInstanceCreate,
+		Read:   resourceMsSqlManagedInstanceRead,
+		Update: resourceMsSqlManagedInstanceUpdate,
+		Delete: resourceMsSqlManagedInstanceDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ManagedInstanceID(id)
+			return err
+		}),
+
+		// provisioning a Managed Instance (and scaling it) can take upwards of 6 hours
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(24 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(24 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(24 * time.Hour),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateMsSqlManagedInstanceName,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GP_Gen4",
+					"GP_Gen5",
+					"BC_Gen4",
+					"BC_Gen5",
+				}, false),
+			},
+
+			"administrator_login": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"administrator_login_password": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+
+			"license_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.ManagedInstanceLicenseTypeBasePrice),
+					string(sql.ManagedInstanceLicenseTypeLicenseIncluded),
+				}, false),
+			},
+
+			"vcores": {
+				Type:     pluginsdk.TypeInt,
+				Required: true,
+				ValidateFunc: validation.IntInSlice([]int{
+					4,
+					8,
+					16,
+					24,
+					32,
+					40,
+					64,
+					80,
+				}),
+			},
+
+			"storage_size_in_gb": {
+				Type:     pluginsdk.TypeInt,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.IntBetween(32, 8192),
+					validation.IntDivisibleBy(32),
+				),
+			},
+
+			"collation": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "SQL_Latin1_General_CP1_CI_AS",
+				ValidateFunc: validate.DatabaseCollation(),
+			},
+
+			"dns_zone_partner_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedInstanceID,
+			},
+
+			"identity": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+							}, false),
+						},
+						"principal_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"minimum_tls_version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "1.2",
+				ValidateFunc: validation.StringInSlice([]string{
+					"1.0",
+					"1.1",
+					"1.2",
+				}, false),
+			},
+
+			"proxy_override": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(sql.ManagedInstanceProxyOverrideDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.ManagedInstanceProxyOverrideDefault),
+					string(sql.ManagedInstanceProxyOverrideProxy),
+					string(sql.ManagedInstanceProxyOverrideRedirect),
+				}, false),
+			},
+
+			"public_data_endpoint_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"storage_account_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(sql.GRS),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.GRS),
+					string(sql.LRS),
+					string(sql.ZRS),
+				}, false),
+			},
+
+			"timezone_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "UTC",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"dns_zone": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"fqdn": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.ForceNewIfChange("identity", func(ctx context.Context, old, new, _ interface{}) bool {
+				// a System Assigned Identity can be enabled on an existing Managed Instance but can't be removed
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+		),
+	}
+}
+
+func resourceMsSqlManagedInstanceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.ManagedInstancesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewManagedInstanceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_mssql_managed_instance", id.ID())
+	}
+
+	parameters := sql.ManagedInstance{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Sku: &sql.Sku{
+			Name: utils.String(d.Get("sku_name").(string)),
+		},
+		ManagedInstanceProperties: &sql.ManagedInstanceProperties{
+			AdministratorLogin:         utils.String(d.Get("administrator_login").(string)),
+			AdministratorLoginPassword: utils.String(d.Get("administrator_login_password").(string)),
+			Collation:                  utils.String(d.Get("collation").(string)),
+			LicenseType:                sql.ManagedInstanceLicenseType(d.Get("license_type").(string)),
+			MinimalTLSVersion:          utils.String(d.Get("minimum_tls_version").(string)),
+			ProxyOverride:              sql.ManagedInstanceProxyOverride(d.Get("proxy_override").(string)),
+			PublicDataEndpointEnabled:  utils.Bool(d.Get("public_data_endpoint_enabled").(bool)),
+			StorageAccountType:         sql.StorageAccountType(d.Get("storage_account_type").(string)),
+			StorageSizeInGB:            utils.Int32(int32(d.Get("storage_size_in_gb").(int))),
+			SubnetID:                   utils.String(d.Get("subnet_id").(string)),
+			TimezoneID:                 utils.String(d.Get("timezone_id").(string)),
+			VCores:                     utils.Int32(int32(d.Get("vcores").(int))),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, ok := d.GetOk("identity"); ok {
+		parameters.Identity = expandSqlServerIdentity(d)
+	}
+
+	if v := d.Get("dns_zone_partner_id").(string); v != "" {
+		parameters.ManagedInstanceProperties.DNSZonePartner = utils.String(v)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMsSqlManagedInstanceRead(d, meta)
+}
+
+func resourceMsSqlManagedInstanceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.ManagedInstancesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := sql.ManagedInstanceUpdate{
+		Sku: &sql.Sku{
+			Name: utils.String(d.Get("sku_name").(string)),
+		},
+		ManagedInstanceProperties: &sql.ManagedInstanceProperties{
+			LicenseType:               sql.ManagedInstanceLicenseType(d.Get("license_type").(string)),
+			MinimalTLSVersion:         utils.String(d.Get("minimum_tls_version").(string)),
+			ProxyOverride:             sql.ManagedInstanceProxyOverride(d.Get("proxy_override").(string)),
+			PublicDataEndpointEnabled: utils.Bool(d.Get("public_data_endpoint_enabled").(bool)),
+			StorageSizeInGB:           utils.Int32(int32(d.Get("storage_size_in_gb").(int))),
+			VCores:                    utils.Int32(int32(d.Get("vcores").(int))),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if d.HasChange("administrator_login_password") {
+		parameters.ManagedInstanceProperties.AdministratorLoginPassword = utils.String(d.Get("administrator_login_password").(string))
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	// the Identity can't be updated using a PATCH, so this needs to be a PUT of the existing resource
+	if _, ok := d.GetOk("identity"); ok && d.HasChange("identity") {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		existing.Identity = expandSqlServerIdentity(d)
+		identityFuture, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, existing)
+		if err != nil {
+			return fmt.Errorf("updating Identity for %s: %+v", id, err)
+		}
+
+		if err := identityFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of Identity for %s: %+v", id, err)
+		}
+	}
+
+	return resourceMsSqlManagedInstanceRead(d, meta)
+}
+
+func resourceMsSqlManagedInstanceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.ManagedInstancesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	skuName := ""
+	if resp.Sku != nil && resp.Sku.Name != nil {
+		skuName = *resp.Sku.Name
+	}
+	d.Set("sku_name", skuName)
+
+	if err := d.Set("identity", flattenSqlServerIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	if props := resp.ManagedInstanceProperties; props != nil {
+		d.Set("administrator_login", props.AdministratorLogin)
+		d.Set("collation", props.Collation)
+		d.Set("dns_zone", props.DNSZone)
+		d.Set("fqdn", props.FullyQualifiedDomainName)
+		d.Set("license_type", string(props.LicenseType))
+		d.Set("minimum_tls_version", props.MinimalTLSVersion)
+		d.Set("proxy_override", string(props.ProxyOverride))
+		d.Set("public_data_endpoint_enabled", props.PublicDataEndpointEnabled)
+		d.Set("storage_account_type", string(props.StorageAccountType))
+		d.Set("subnet_id", props.SubnetID)
+		d.Set("timezone_id", props.TimezoneID)
+
+		storageSizeInGb := 0
+		if props.StorageSizeInGB != nil {
+			storageSizeInGb = int(*props.StorageSizeInGB)
+		}
+		d.Set("storage_size_in_gb", storageSizeInGb)
+
+		vCores := 0
+		if props.VCores != nil {
+			vCores = int(*props.VCores)
+		}
+		d.Set("vcores", vCores)
+
+		// the DNS Zone Partner isn't returned by the API, so we can only keep what's in the config/state
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceMsSqlManagedInstanceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.ManagedInstancesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+	}
+
+	return nil
+}
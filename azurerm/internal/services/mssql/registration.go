@@ -21,9 +21,10 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_mssql_database":    dataSourceMsSqlDatabase(),
-		"azurerm_mssql_elasticpool": dataSourceMsSqlElasticpool(),
-		"azurerm_mssql_server":      dataSourceMsSqlServer(),
+		"azurerm_mssql_database":         dataSourceMsSqlDatabase(),
+		"azurerm_mssql_elasticpool":      dataSourceMsSqlElasticpool(),
+		"azurerm_mssql_managed_instance": dataSourceMsSqlManagedInstance(),
+		"azurerm_mssql_server":           dataSourceMsSqlServer(),
 	}
 }
 
@@ -34,9 +35,13 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_mssql_database_extended_auditing_policy":               resourceMsSqlDatabaseExtendedAuditingPolicy(),
 		"azurerm_mssql_database_vulnerability_assessment_rule_baseline": resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaseline(),
 		"azurerm_mssql_elasticpool":                                     resourceMsSqlElasticPool(),
+		"azurerm_mssql_failover_group":                                  resourceMsSqlFailoverGroup(),
 		"azurerm_mssql_job_agent":                                       resourceMsSqlJobAgent(),
 		"azurerm_mssql_job_credential":                                  resourceMsSqlJobCredential(),
 		"azurerm_mssql_firewall_rule":                                   resourceMsSqlFirewallRule(),
+		"azurerm_mssql_managed_database":                                resourceMsSqlManagedDatabase(),
+		"azurerm_mssql_managed_instance":                                resourceMsSqlManagedInstance(),
+		"azurerm_mssql_managed_instance_failover_group":                 resourceMsSqlManagedInstanceFailoverGroup(),
 		"azurerm_mssql_server":                                          resourceMsSqlServer(),
 		"azurerm_mssql_server_extended_auditing_policy":                 resourceMsSqlServerExtendedAuditingPolicy(),
 		"azurerm_mssql_server_security_alert_policy":                    resourceMsSqlServerSecurityAlertPolicy(),
@@ -207,7 +207,9 @@ func resourceNetworkSecurityGroupCreateUpdate(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("Error Building list of Network Security Group Rules: %+v", sgErr)
 	}
 
-	locks.ByName(name, networkSecurityGroupResourceName)
+	if err := locks.ByNameWithContext(ctx, name, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(name, networkSecurityGroupResourceName)
 
 	sg := network.SecurityGroup{
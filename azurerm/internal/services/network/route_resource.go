@@ -105,7 +105,9 @@ func resourceRouteCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
-	locks.ByName(id.RouteTableName, routeTableResourceName)
+	if err := locks.ByNameWithContext(ctx, id.RouteTableName, routeTableResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.RouteTableName, routeTableResourceName)
 
 	route := network.Route{
@@ -175,7 +177,9 @@ func resourceRouteDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.RouteTableName, routeTableResourceName)
+	if err := locks.ByNameWithContext(ctx, id.RouteTableName, routeTableResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(id.RouteTableName, routeTableResourceName)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.RouteTableName, id.Name)
@@ -72,11 +72,17 @@ func resourceSubnetNatGatewayAssociationCreate(d *pluginsdk.ResourceData, meta i
 
 	gatewayName := parsedGatewayId.Name
 
-	locks.ByName(gatewayName, natGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, gatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(gatewayName, natGatewayResourceName)
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(ctx, virtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
-	locks.ByName(subnetName, SubnetResourceName)
+	if err := locks.ByNameWithContext(ctx, subnetName, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(subnetName, SubnetResourceName)
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
@@ -195,9 +201,13 @@ func resourceSubnetNatGatewayAssociationDelete(d *pluginsdk.ResourceData, meta i
 	}
 
 	gatewayName := parsedGatewayId.Path["natGateways"]
-	locks.ByName(gatewayName, natGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, gatewayName, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(gatewayName, natGatewayResourceName)
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(ctx, virtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
 
 	// ensure we get the latest state
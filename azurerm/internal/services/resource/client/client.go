@@ -15,6 +15,7 @@ type Client struct {
 	ProvidersClient             *providers.ProvidersClient
 	ResourceProvidersClient     *resources.ProvidersClient
 	ResourcesClient             *resources.Client
+	TagsClient                  *resources.TagsClient
 	TemplateSpecsVersionsClient *templatespecs.VersionsClient
 }
 
@@ -39,6 +40,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	resourcesClient := resources.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&resourcesClient.Client, o.ResourceManagerAuthorizer)
 
+	tagsClient := resources.NewTagsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&tagsClient.Client, o.ResourceManagerAuthorizer)
+
 	templatespecsVersionsClient := templatespecs.NewVersionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&templatespecsVersionsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -49,6 +53,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ProvidersClient:             &providersClient,
 		ResourceProvidersClient:     &resourceProvidersClient,
 		ResourcesClient:             &resourcesClient,
+		TagsClient:                  &tagsClient,
 		TemplateSpecsVersionsClient: &templatespecsVersionsClient,
 	}
 }
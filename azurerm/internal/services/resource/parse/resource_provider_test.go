@@ -1,6 +1,6 @@
 package parse
 
-// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+// NOTE: ResourceProvider is manually maintained since the generator doesn't support outputting this information at this time
 
 import (
 	"testing"
@@ -7,6 +7,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/fakearm"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -29,6 +30,39 @@ func TestAccResourceGroup_basic(t *testing.T) {
 	})
 }
 
+func TestResourceGroup_lifecycleAgainstFakeServer(t *testing.T) {
+	server := fakearm.NewServer(t)
+	lifecycle := server.Lifecycle(t, "azurerm_resource_group")
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-fake", fakearm.SubscriptionId)
+
+	state := lifecycle.Apply(map[string]interface{}{
+		"name":     "acctestRG-fake",
+		"location": "West Europe",
+	})
+	if state.ID != id {
+		t.Fatalf("expected the ID to be %q but got %q", id, state.ID)
+	}
+	if v := state.Attributes["location"]; v != "westeurope" {
+		t.Fatalf("expected the location to be %q but got %q", "westeurope", v)
+	}
+
+	state = lifecycle.Apply(map[string]interface{}{
+		"name":     "acctestRG-fake",
+		"location": "West Europe",
+		"tags": map[string]interface{}{
+			"environment": "Production",
+		},
+	})
+	if v := state.Attributes["tags.environment"]; v != "Production" {
+		t.Fatalf("expected the tag `environment` to be %q but got %q", "Production", v)
+	}
+
+	lifecycle.Destroy()
+	if _, exists := server.Resource(id); exists {
+		t.Fatalf("expected the Resource Group to have been deleted")
+	}
+}
+
 func TestAccResourceGroup_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
 	testResource := ResourceGroupResource{}
@@ -3,16 +3,20 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"io"
+	"log"
+	"net/http"
 	"os"
 	"runtime"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
 )
@@ -150,15 +154,31 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
-	input := blobs.PutBlockBlobInput{
-		ContentType: utils.String(sbu.ContentType),
-		MetaData:    sbu.MetaData,
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
 	}
-	if sbu.ContentMD5 != "" {
-		input.ContentMD5 = utils.String(sbu.ContentMD5)
+
+	fileSize := info.Size()
+
+	// files which fit into a single block can be uploaded in a single request
+	if fileSize <= defaultBlockSize {
+		input := blobs.PutBlockBlobInput{
+			ContentType: utils.String(sbu.ContentType),
+			MetaData:    sbu.MetaData,
+		}
+		if sbu.ContentMD5 != "" {
+			input.ContentMD5 = utils.String(sbu.ContentMD5)
+		}
+		if err := sbu.Client.PutBlockBlobFromFile(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, file, input); err != nil {
+			return fmt.Errorf("Error PutBlockBlobFromFile: %s", err)
+		}
+
+		return nil
 	}
-	if err := sbu.Client.PutBlockBlobFromFile(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, file, input); err != nil {
-		return fmt.Errorf("Error PutBlockBlobFromFile: %s", err)
+
+	if err := sbu.blockUploadFromSource(ctx, file, fileSize); err != nil {
+		return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 	}
 
 	return nil
@@ -363,6 +383,197 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+const (
+	// defaultBlockSize is the size of each Block staged when uploading a Block Blob, which is
+	// increased for files which would otherwise exceed the maximum number of Blocks
+	defaultBlockSize int64 = 4 * 1024 * 1024
+
+	// maxBlockCount is the maximum number of Blocks which can be committed to a Block Blob
+	maxBlockCount int64 = 50000
+
+	// maxBlockUploadAttempts is the number of times the upload of a single Block is attempted
+	maxBlockUploadAttempts = 5
+)
+
+// blockUploadRetryBackoff is the initial delay between attempts to upload a Block, which is doubled for each attempt
+var blockUploadRetryBackoff = 5 * time.Second
+
+type storageBlobBlock struct {
+	index   int64
+	section *io.SectionReader
+}
+
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, file io.ReaderAt, fileSize int64) error {
+	blockSize := defaultBlockSize
+	for (fileSize+blockSize-1)/blockSize > maxBlockCount {
+		blockSize *= 2
+	}
+	blockCount := (fileSize + blockSize - 1) / blockSize
+
+	// Blocks are kept (uncommitted) for a week, so any Blocks staged by a previous attempt at uploading
+	// this file (with the same contents) don't need to be uploaded again
+	staged, err := sbu.uncommittedBlockIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	blocks := make(chan storageBlobBlock, blockCount)
+	for i := int64(0); i < blockCount; i++ {
+		offset := i * blockSize
+		length := blockSize
+		if offset+length > fileSize {
+			length = fileSize - offset
+		}
+
+		blocks <- storageBlobBlock{
+			index:   i,
+			section: io.NewSectionReader(file, offset, length),
+		}
+	}
+	close(blocks)
+
+	workerCount := int64(sbu.Parallelism * runtime.NumCPU())
+	if workerCount > blockCount {
+		workerCount = blockCount
+	}
+
+	blockIDs := make([]blobs.BlockID, blockCount)
+	errors := make(chan error, blockCount)
+	wg := &sync.WaitGroup{}
+	wg.Add(int(workerCount))
+
+	for i := int64(0); i < workerCount; i++ {
+		go sbu.blobBlockUploadWorker(ctx, blobBlockUploadContext{
+			blocks:   blocks,
+			blockIDs: blockIDs,
+			staged:   staged,
+			errors:   errors,
+			wg:       wg,
+		})
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return fmt.Errorf("Error while uploading source file %q: %s", sbu.Source, <-errors)
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIDs,
+		},
+		ContentType: utils.String(sbu.ContentType),
+		MetaData:    sbu.MetaData,
+	}
+	if sbu.ContentMD5 != "" {
+		input.ContentMD5 = utils.String(sbu.ContentMD5)
+	}
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("Error PutBlockList: %s", err)
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) uncommittedBlockIDs(ctx context.Context) (map[string]struct{}, error) {
+	staged := make(map[string]struct{})
+
+	input := blobs.GetBlockListInput{
+		BlockListType: blobs.Uncommitted,
+	}
+	resp, err := sbu.Client.GetBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return staged, nil
+		}
+
+		return nil, fmt.Errorf("Error retrieving uncommitted blocks: %s", err)
+	}
+
+	for _, block := range resp.UncommittedBlocks.Blocks {
+		staged[block.Name] = struct{}{}
+	}
+
+	return staged, nil
+}
+
+type blobBlockUploadContext struct {
+	blocks   chan storageBlobBlock
+	blockIDs []blobs.BlockID
+	staged   map[string]struct{}
+	errors   chan error
+	wg       *sync.WaitGroup
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, uploadCtx blobBlockUploadContext) {
+	defer uploadCtx.wg.Done()
+
+	for block := range uploadCtx.blocks {
+		chunk := make([]byte, block.section.Size())
+		if _, err := block.section.ReadAt(chunk, 0); err != nil && err != io.EOF {
+			uploadCtx.errors <- fmt.Errorf("Error reading block %d of source file %q: %s", block.index, sbu.Source, err)
+			continue
+		}
+
+		checksum := md5.Sum(chunk)
+		blockID := storageBlobBlockID(block.index, checksum)
+		uploadCtx.blockIDs[block.index] = blobs.BlockID{Value: blockID}
+
+		if _, ok := uploadCtx.staged[blockID]; ok {
+			continue
+		}
+
+		input := blobs.PutBlockInput{
+			BlockID:    blockID,
+			Content:    chunk,
+			ContentMD5: utils.String(base64.StdEncoding.EncodeToString(checksum[:])),
+		}
+		if err := sbu.putBlockWithRetries(ctx, input); err != nil {
+			uploadCtx.errors <- fmt.Errorf("Error writing block %d for file %q: %s", block.index, sbu.Source, err)
+			continue
+		}
+	}
+}
+
+// storageBlobBlockID returns the Block ID for a Block, which is derived from the position and contents of the
+// Block to allow an upload to be resumed - Block IDs must be the same length for all Blocks within a Blob
+func storageBlobBlockID(index int64, checksum [md5.Size]byte) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d-%s", index, hex.EncodeToString(checksum[:]))))
+}
+
+func (sbu BlobUpload) putBlockWithRetries(ctx context.Context, input blobs.PutBlockInput) error {
+	backoff := blockUploadRetryBackoff
+	for attempt := 1; ; attempt++ {
+		resp, err := sbu.Client.PutBlock(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input)
+		if err == nil {
+			return nil
+		}
+
+		if attempt == maxBlockUploadAttempts || !blockUploadIsRetryable(resp.Response) {
+			return err
+		}
+
+		log.Printf("[DEBUG] Uploading Block %q failed (attempt %d of %d) - retrying in %s: %s", input.BlockID, attempt, maxBlockUploadAttempts, backoff, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// blockUploadIsRetryable returns whether the upload of a Block failed due to a transient error, such as a
+// network error, the request being throttled or a server-side error
+func blockUploadIsRetryable(resp autorest.Response) bool {
+	if resp.Response == nil {
+		return true
+	}
+
+	statusCode := resp.StatusCode
+	return statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
@@ -0,0 +1,276 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+)
+
+// clientRetryAttempts is the number of times the client itself retries a failed request, meaning
+// each attempt at uploading a Block sends up to `clientRetryAttempts + 1` requests
+const clientRetryAttempts = 1
+
+// fakeBlobServer is a minimal stand-in for the Blob Storage API, supporting the operations used
+// when uploading a Block Blob (Put Blob, Put Block, Get Block List and Put Block List)
+type fakeBlobServer struct {
+	sync.Mutex
+
+	// failPutBlockAttempts is the number of times each Put Block request fails before succeeding
+	failPutBlockAttempts int
+
+	attempts    map[string]int
+	blobs       map[string][]byte
+	contentType map[string]string
+	uncommitted map[string][]byte
+	putBlocks   int
+	putBlobs    int
+}
+
+func newFakeBlobServer() *fakeBlobServer {
+	return &fakeBlobServer{
+		attempts:    make(map[string]int),
+		blobs:       make(map[string][]byte),
+		contentType: make(map[string]string),
+		uncommitted: make(map[string][]byte),
+	}
+}
+
+func (s *fakeBlobServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	path := r.URL.Path
+	query := r.URL.Query()
+	switch {
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		blockID := query.Get("blockid")
+		s.attempts[blockID]++
+		if s.attempts[blockID] <= s.failPutBlockAttempts {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		s.putBlocks++
+		s.uncommitted[blockID] = body
+		w.WriteHeader(http.StatusCreated)
+
+	case r.Method == http.MethodGet && query.Get("comp") == "blocklist":
+		if len(s.uncommitted) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		type block struct {
+			Name string `xml:"Name"`
+			Size int    `xml:"Size"`
+		}
+		result := struct {
+			XMLName xml.Name `xml:"BlockList"`
+			Blocks  []block  `xml:"UncommittedBlocks>Block"`
+		}{}
+		for id, content := range s.uncommitted {
+			result.Blocks = append(result.Blocks, block{Name: id, Size: len(content)})
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusOK)
+		xml.NewEncoder(w).Encode(result) // nolint: errcheck
+
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		var blockList blobs.BlockList
+		if err := xml.Unmarshal(body, &blockList); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var content []byte
+		for _, id := range blockList.LatestBlockIDs {
+			block, ok := s.uncommitted[id.Value]
+			if !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			content = append(content, block...)
+		}
+
+		s.blobs[path] = content
+		s.contentType[path] = r.Header.Get("x-ms-blob-content-type")
+		s.uncommitted = make(map[string][]byte)
+		w.WriteHeader(http.StatusCreated)
+
+	case r.Method == http.MethodPut && r.Header.Get("x-ms-blob-type") == "BlockBlob":
+		s.putBlobs++
+		s.blobs[path] = body
+		s.contentType[path] = r.Header.Get("x-ms-blob-content-type")
+		w.WriteHeader(http.StatusCreated)
+
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func (s *fakeBlobServer) client(t *testing.T) *blobs.Client {
+	httpServer := httptest.NewServer(s)
+	t.Cleanup(httpServer.Close)
+
+	// requests are sent to `https://{account}.blob.{suffix}` so are redirected to the local server
+	client := blobs.New()
+	client.RetryAttempts = clientRetryAttempts
+	client.RetryDuration = time.Millisecond
+	client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		r.URL.Scheme = "http"
+		r.URL.Host = strings.TrimPrefix(httpServer.URL, "http://")
+		return http.DefaultClient.Do(r)
+	})
+	return &client
+}
+
+func testBlobUploadFile(t *testing.T, fileSize int64) ([]byte, string) {
+	content := make([]byte, fileSize)
+	if _, err := rand.Read(content); err != nil {
+		t.Fatalf("generating content: %+v", err)
+	}
+
+	file, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("creating temp file: %+v", err)
+	}
+	t.Cleanup(func() {
+		os.Remove(file.Name())
+	})
+
+	if _, err := file.Write(content); err != nil {
+		t.Fatalf("writing temp file: %+v", err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatalf("closing temp file: %+v", err)
+	}
+
+	return content, file.Name()
+}
+
+func testBlobUpload(t *testing.T, server *fakeBlobServer, fileSize int64) error {
+	backoff := blockUploadRetryBackoff
+	blockUploadRetryBackoff = time.Millisecond
+	defer func() {
+		blockUploadRetryBackoff = backoff
+	}()
+
+	content, fileName := testBlobUploadFile(t, fileSize)
+	upload := BlobUpload{
+		Client:        server.client(t),
+		AccountName:   "account1",
+		ContainerName: "container1",
+		BlobName:      "blob1",
+		BlobType:      "Block",
+		ContentType:   "application/x-test",
+		Parallelism:   2,
+		Source:        fileName,
+	}
+	if err := upload.Create(context.TODO()); err != nil {
+		return err
+	}
+
+	path := "/container1/blob1"
+	if !bytes.Equal(server.blobs[path], content) {
+		t.Fatalf("expected the uploaded blob (%d bytes) to match the source file (%d bytes)", len(server.blobs[path]), len(content))
+	}
+	if server.contentType[path] != "application/x-test" {
+		t.Fatalf("expected the Content Type to be %q but got %q", "application/x-test", server.contentType[path])
+	}
+
+	return nil
+}
+
+func TestBlobUpload_BlockBlobSingleRequest(t *testing.T) {
+	server := newFakeBlobServer()
+	if err := testBlobUpload(t, server, 1024); err != nil {
+		t.Fatalf("uploading blob: %+v", err)
+	}
+
+	if server.putBlobs != 1 || server.putBlocks != 0 {
+		t.Fatalf("expected a single Put Blob request but got %d Put Blob and %d Put Block requests", server.putBlobs, server.putBlocks)
+	}
+}
+
+func TestBlobUpload_BlockBlobStaged(t *testing.T) {
+	server := newFakeBlobServer()
+	if err := testBlobUpload(t, server, 2*defaultBlockSize+512); err != nil {
+		t.Fatalf("uploading blob: %+v", err)
+	}
+
+	if server.putBlobs != 0 || server.putBlocks != 3 {
+		t.Fatalf("expected 3 Put Block requests but got %d Put Blob and %d Put Block requests", server.putBlobs, server.putBlocks)
+	}
+}
+
+func TestBlobUpload_BlockBlobStagedWithRetries(t *testing.T) {
+	server := newFakeBlobServer()
+	server.failPutBlockAttempts = maxBlockUploadAttempts*(clientRetryAttempts+1) - 1
+	if err := testBlobUpload(t, server, 2*defaultBlockSize+512); err != nil {
+		t.Fatalf("uploading blob: %+v", err)
+	}
+
+	if server.putBlocks != 3 {
+		t.Fatalf("expected 3 successful Put Block requests but got %d", server.putBlocks)
+	}
+}
+
+func TestBlobUpload_BlockBlobStagedRetriesExhausted(t *testing.T) {
+	server := newFakeBlobServer()
+	server.failPutBlockAttempts = maxBlockUploadAttempts * (clientRetryAttempts + 1)
+	if err := testBlobUpload(t, server, 2*defaultBlockSize+512); err == nil {
+		t.Fatalf("expected an error when the retries for a block are exhausted")
+	}
+
+	if len(server.blobs) != 0 {
+		t.Fatalf("expected the Block List not to be committed")
+	}
+}
+
+func TestBlobUpload_BlockBlobStagedResumed(t *testing.T) {
+	content, fileName := testBlobUploadFile(t, 2*defaultBlockSize+512)
+
+	// the first block was staged by a previous upload which failed part of the way through
+	server := newFakeBlobServer()
+	firstBlock := content[:defaultBlockSize]
+	server.uncommitted[storageBlobBlockID(0, md5.Sum(firstBlock))] = firstBlock
+
+	upload := BlobUpload{
+		Client:        server.client(t),
+		AccountName:   "account1",
+		ContainerName: "container1",
+		BlobName:      "blob1",
+		BlobType:      "Block",
+		Parallelism:   2,
+		Source:        fileName,
+	}
+	if err := upload.Create(context.TODO()); err != nil {
+		t.Fatalf("resuming upload: %+v", err)
+	}
+
+	if server.putBlocks != 2 {
+		t.Fatalf("expected 2 Put Block requests when resuming but got %d", server.putBlocks)
+	}
+	if !bytes.Equal(server.blobs["/container1/blob1"], content) {
+		t.Fatalf("expected the resumed blob to match the source file")
+	}
+}
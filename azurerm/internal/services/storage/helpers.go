@@ -1,6 +1,9 @@
 package storage
 
 import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
 )
@@ -72,3 +75,196 @@ func schemaStorageAccountCorsRule(patchEnabled bool) *pluginsdk.Schema {
 		},
 	}
 }
+
+// storageAccountBlobPropertiesSchema returns the Schema for the Blob Service Properties of a Storage Account, which is used
+// for both the `blob_properties` block within `azurerm_storage_account` and `azurerm_storage_account_blob_properties`
+func storageAccountBlobPropertiesSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cors_rule": schemaStorageAccountCorsRule(true),
+		"delete_retention_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      7,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+				},
+			},
+		},
+
+		"versioning_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"change_feed_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"default_service_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.BlobPropertiesDefaultServiceVersion,
+		},
+
+		"last_access_time_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"container_delete_retention_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      7,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+				},
+			},
+		},
+	}
+}
+
+// storageAccountSharePropertiesSchema returns the Schema for the File Service Properties of a Storage Account, which is used
+// for both the `share_properties` block within `azurerm_storage_account` and `azurerm_storage_account_share_properties`
+func storageAccountSharePropertiesSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cors_rule": schemaStorageAccountCorsRule(true),
+
+		"retention_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      7,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+				},
+			},
+		},
+
+		"smb": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"versions": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SMB2.1",
+								"SMB3.0",
+								"SMB3.1.1",
+							}, false),
+						},
+					},
+
+					"authentication_types": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								"NTLMv2",
+								"Kerberos",
+							}, false),
+						},
+					},
+
+					"kerberos_ticket_encryption_type": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								"RC4-HMAC",
+								"AES-256",
+							}, false),
+						},
+					},
+
+					"channel_encryption_type": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								"AES-128-CCM",
+								"AES-128-GCM",
+								"AES-256-GCM",
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// storageAccountStaticWebsiteSchema returns the Schema for the Static Website of a Storage Account, which is used
+// for both the `static_website` block within `azurerm_storage_account` and `azurerm_storage_account_static_website`
+func storageAccountStaticWebsiteSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"index_document": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"error_404_document": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+// expandStorageAccountServicePropertiesBlock returns the top-level fields of a standalone Service Properties resource
+// (e.g. `azurerm_storage_account_blob_properties`) in the same shape as the equivalent block within the
+// `azurerm_storage_account` resource, so that the same expand functions can be used for both
+func expandStorageAccountServicePropertiesBlock(d *pluginsdk.ResourceData, schema map[string]*pluginsdk.Schema) []interface{} {
+	block := make(map[string]interface{})
+	for key := range schema {
+		block[key] = d.Get(key)
+	}
+
+	return []interface{}{block}
+}
+
+// flattenStorageAccountServicePropertiesBlock sets the top-level fields of a standalone Service Properties resource from
+// the equivalent block within the `azurerm_storage_account` resource - resetting any fields which aren't returned
+func flattenStorageAccountServicePropertiesBlock(d *pluginsdk.ResourceData, schema map[string]*pluginsdk.Schema, input []interface{}) error {
+	block := make(map[string]interface{})
+	if len(input) > 0 && input[0] != nil {
+		block = input[0].(map[string]interface{})
+	}
+
+	for key := range schema {
+		if err := d.Set(key, block[key]); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// sourceHashSchema returns the Schema for the `source_hash` field, which tracks the contents of the
+// local file specified in `source` so that changes to the file (rather than the path) can be detected
+func sourceHashSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Computed: true,
+	}
+}
+
+// sourceHashCustomizeDiff computes the hash of the local file specified in `source` at plan time and
+// marks `source_hash` as changed when the file contents differ from those which were last uploaded
+func sourceHashCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("source") {
+		return d.SetNewComputed("source_hash")
+	}
+
+	source := d.Get("source").(string)
+	if source == "" {
+		if d.Get("source_hash").(string) != "" {
+			return d.SetNew("source_hash", "")
+		}
+		return nil
+	}
+
+	hash, err := hashLocalFile(source)
+	if err != nil {
+		// the file may be generated by another resource during the apply
+		if os.IsNotExist(err) {
+			return d.SetNewComputed("source_hash")
+		}
+
+		return fmt.Errorf("computing hash of `source` %q: %+v", source, err)
+	}
+
+	if d.Get("source_hash").(string) != hash {
+		return d.SetNew("source_hash", hash)
+	}
+
+	return nil
+}
+
+// setUploadedSourceHash sets `source_hash` once the local file specified in `source` has been uploaded. The
+// hash calculated during the plan is used where known, rather than reading the file again - since the file
+// may have changed since the plan, which would otherwise lead to an inconsistent result. Where the hash was
+// unknown during the plan (e.g. the file is generated by another resource) the file is read instead.
+//
+// Where the file has changed since the plan, the new contents will have been uploaded - and the file will be
+// uploaded again during the next apply, since the hash in the State differs from the hash of the file.
+func setUploadedSourceHash(d *pluginsdk.ResourceData) error {
+	if d.Get("source").(string) == "" {
+		return d.Set("source_hash", "")
+	}
+
+	if d.Get("source_hash").(string) != "" {
+		return nil
+	}
+
+	return setSourceHash(d)
+}
+
+// setSourceHash sets `source_hash` to the hash of the local file specified in `source`, if any
+func setSourceHash(d *pluginsdk.ResourceData) error {
+	hash := ""
+	if source := d.Get("source").(string); source != "" {
+		v, err := hashLocalFile(source)
+		if err != nil {
+			return fmt.Errorf("computing hash of `source` %q: %+v", source, err)
+		}
+		hash = v
+	}
+
+	return d.Set("source_hash", hash)
+}
+
+// hashLocalFile returns the hex encoded SHA256 sum of the contents of the file at the specified path
+func hashLocalFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,210 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceStorageAccountBlobProperties() *pluginsdk.Resource {
+	schema := storageAccountBlobPropertiesSchema()
+	schema["storage_account_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.StorageAccountID,
+	}
+
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountBlobPropertiesCreateUpdate,
+		Read:   resourceStorageAccountBlobPropertiesRead,
+		Update: resourceStorageAccountBlobPropertiesCreateUpdate,
+		Delete: resourceStorageAccountBlobPropertiesDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BlobServiceID(id)
+			return err
+		}),
+
+		Schema: schema,
+	}
+}
+
+func resourceStorageAccountBlobPropertiesCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	accountsClient := meta.(*clients.Client).Storage.AccountsClient
+	client := meta.(*clients.Client).Storage.BlobServicesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	storageAccountId, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewBlobServiceID(storageAccountId.SubscriptionId, storageAccountId.ResourceGroup, storageAccountId.Name, "default")
+
+	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+	account, err := accountsClient.GetProperties(ctx, id.ResourceGroup, id.StorageAccountName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", storageAccountId, err)
+	}
+
+	// FileStorage does not support blob settings
+	if account.Kind == storage.FileStorage {
+		return fmt.Errorf("Blob Properties aren't supported for File Storage accounts")
+	}
+
+	if d.IsNewResource() {
+		existing, err := client.GetServiceProperties(ctx, id.ResourceGroup, id.StorageAccountName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+		}
+
+		if checkForNonDefaultBlobProperties(existing) {
+			return tf.ImportAsExistsError("azurerm_storage_account_blob_properties", id.ID())
+		}
+	}
+
+	props := expandBlobProperties(expandStorageAccountServicePropertiesBlock(d, storageAccountBlobPropertiesSchema()))
+
+	// last_access_time_enabled and container_delete_retention_policy are not supported in USGov
+	// as such these are only sent when they've been configured/changed
+	if d.IsNewResource() {
+		if v := d.Get("last_access_time_enabled").(bool); v {
+			props.LastAccessTimeTrackingPolicy = &storage.LastAccessTimeTrackingPolicy{
+				Enable: utils.Bool(v),
+			}
+		}
+
+		if v, ok := d.GetOk("container_delete_retention_policy"); ok {
+			props.ContainerDeleteRetentionPolicy = expandBlobPropertiesDeleteRetentionPolicy(v.([]interface{}), false)
+		}
+	} else {
+		if d.HasChange("last_access_time_enabled") {
+			props.LastAccessTimeTrackingPolicy = &storage.LastAccessTimeTrackingPolicy{
+				Enable: utils.Bool(d.Get("last_access_time_enabled").(bool)),
+			}
+		}
+
+		if d.HasChange("container_delete_retention_policy") {
+			props.ContainerDeleteRetentionPolicy = expandBlobPropertiesDeleteRetentionPolicy(d.Get("container_delete_retention_policy").([]interface{}), true)
+		}
+	}
+
+	if _, err := client.SetServiceProperties(ctx, id.ResourceGroup, id.StorageAccountName, *props); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceStorageAccountBlobPropertiesRead(d, meta)
+}
+
+func resourceStorageAccountBlobPropertiesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BlobServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetServiceProperties(ctx, id.ResourceGroup, id.StorageAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("storage_account_id", parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName).ID())
+
+	return flattenStorageAccountServicePropertiesBlock(d, storageAccountBlobPropertiesSchema(), flattenBlobProperties(resp))
+}
+
+func resourceStorageAccountBlobPropertiesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BlobServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+	// the Blob Service can't be deleted, so we'll reset the properties back to the defaults instead
+	props := expandBlobProperties([]interface{}{})
+	if d.Get("last_access_time_enabled").(bool) {
+		props.LastAccessTimeTrackingPolicy = &storage.LastAccessTimeTrackingPolicy{
+			Enable: utils.Bool(false),
+		}
+	}
+	if len(d.Get("container_delete_retention_policy").([]interface{})) > 0 {
+		props.ContainerDeleteRetentionPolicy = expandBlobPropertiesDeleteRetentionPolicy([]interface{}{}, true)
+	}
+
+	if _, err := client.SetServiceProperties(ctx, id.ResourceGroup, id.StorageAccountName, *props); err != nil {
+		return fmt.Errorf("resetting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// checkForNonDefaultBlobProperties returns whether any of the Blob Properties for the Storage Account differ
+// from the defaults, in which case these are already being managed (e.g. by the Storage Account resource)
+func checkForNonDefaultBlobProperties(input storage.BlobServiceProperties) bool {
+	props := input.BlobServicePropertiesProperties
+	if props == nil {
+		return false
+	}
+
+	if props.Cors != nil && props.Cors.CorsRules != nil && len(*props.Cors.CorsRules) != 0 {
+		return true
+	}
+	if v := props.DeleteRetentionPolicy; v != nil && v.Enabled != nil && *v.Enabled {
+		return true
+	}
+	if v := props.ContainerDeleteRetentionPolicy; v != nil && v.Enabled != nil && *v.Enabled {
+		return true
+	}
+	if v := props.IsVersioningEnabled; v != nil && *v {
+		return true
+	}
+	if v := props.ChangeFeed; v != nil && v.Enabled != nil && *v.Enabled {
+		return true
+	}
+	if v := props.LastAccessTimeTrackingPolicy; v != nil && v.Enable != nil && *v.Enable {
+		return true
+	}
+	if v := props.DefaultServiceVersion; v != nil && *v != "" {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceStorageAccountShareProperties() *pluginsdk.Resource {
+	schema := storageAccountSharePropertiesSchema()
+	schema["storage_account_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.StorageAccountID,
+	}
+
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountSharePropertiesCreateUpdate,
+		Read:   resourceStorageAccountSharePropertiesRead,
+		Update: resourceStorageAccountSharePropertiesCreateUpdate,
+		Delete: resourceStorageAccountSharePropertiesDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FileServiceID(id)
+			return err
+		}),
+
+		Schema: schema,
+	}
+}
+
+func resourceStorageAccountSharePropertiesCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	accountsClient := meta.(*clients.Client).Storage.AccountsClient
+	client := meta.(*clients.Client).Storage.FileServicesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	storageAccountId, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFileServiceID(storageAccountId.SubscriptionId, storageAccountId.ResourceGroup, storageAccountId.Name, "default")
+
+	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+	account, err := accountsClient.GetProperties(ctx, id.ResourceGroup, id.StorageAccountName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", storageAccountId, err)
+	}
+
+	// BlobStorage, BlockBlobStorage and Premium StorageV2 accounts do not support file share settings
+	// FileStorage Premium is supported
+	isPremium := account.Sku != nil && account.Sku.Tier == storage.Premium
+	if account.Kind != storage.FileStorage && (account.Kind == storage.BlobStorage || account.Kind == storage.BlockBlobStorage || isPremium) {
+		return fmt.Errorf("Share Properties aren't supported for Blob Storage / Block Blob / StorageV2 Premium Storage accounts")
+	}
+
+	if d.IsNewResource() {
+		existing, err := client.GetServiceProperties(ctx, id.ResourceGroup, id.StorageAccountName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+		}
+
+		if checkForNonDefaultShareProperties(existing) {
+			return tf.ImportAsExistsError("azurerm_storage_account_share_properties", id.ID())
+		}
+	}
+
+	props := expandShareProperties(expandStorageAccountServicePropertiesBlock(d, storageAccountSharePropertiesSchema()))
+	if _, err := client.SetServiceProperties(ctx, id.ResourceGroup, id.StorageAccountName, props); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceStorageAccountSharePropertiesRead(d, meta)
+}
+
+func resourceStorageAccountSharePropertiesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.FileServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FileServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetServiceProperties(ctx, id.ResourceGroup, id.StorageAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("storage_account_id", parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName).ID())
+
+	return flattenStorageAccountServicePropertiesBlock(d, storageAccountSharePropertiesSchema(), flattenShareProperties(resp))
+}
+
+func resourceStorageAccountSharePropertiesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.FileServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FileServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+	// the File Service can't be deleted, so we'll reset the properties back to the defaults instead
+	if _, err := client.SetServiceProperties(ctx, id.ResourceGroup, id.StorageAccountName, expandShareProperties([]interface{}{})); err != nil {
+		return fmt.Errorf("resetting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// checkForNonDefaultShareProperties returns whether any of the Share Properties for the Storage Account differ
+// from the defaults, in which case these are already being managed (e.g. by the Storage Account resource)
+func checkForNonDefaultShareProperties(input storage.FileServiceProperties) bool {
+	props := input.FileServicePropertiesProperties
+	if props == nil {
+		return false
+	}
+
+	if props.Cors != nil && props.Cors.CorsRules != nil && len(*props.Cors.CorsRules) != 0 {
+		return true
+	}
+	if v := props.ShareDeleteRetentionPolicy; v != nil && v.Enabled != nil && *v.Enabled {
+		return true
+	}
+	if props.ProtocolSettings != nil && props.ProtocolSettings.Smb != nil {
+		smb := props.ProtocolSettings.Smb
+		for _, v := range []*string{smb.Versions, smb.AuthenticationMethods, smb.KerberosTicketEncryption, smb.ChannelEncryption} {
+			if v != nil && *v != "" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
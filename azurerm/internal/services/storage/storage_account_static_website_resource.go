@@ -0,0 +1,185 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceStorageAccountStaticWebsite() *pluginsdk.Resource {
+	schema := storageAccountStaticWebsiteSchema()
+	schema["storage_account_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.StorageAccountID,
+	}
+
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountStaticWebsiteCreateUpdate,
+		Read:   resourceStorageAccountStaticWebsiteRead,
+		Update: resourceStorageAccountStaticWebsiteCreateUpdate,
+		Delete: resourceStorageAccountStaticWebsiteDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageAccountID(id)
+			return err
+		}),
+
+		Schema: schema,
+	}
+}
+
+func resourceStorageAccountStaticWebsiteCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	existing, err := storageClient.AccountsClient.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	// static website only supported on StorageV2 and BlockBlobStorage
+	if existing.Kind != storage.StorageV2 && existing.Kind != storage.BlockBlobStorage {
+		return fmt.Errorf("a Static Website is only supported for StorageV2 and BlockBlobStorage accounts")
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q: %+v", id.Name, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate %s", id)
+	}
+
+	client, err := storageClient.AccountsDataPlaneClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Accounts Data Plane Client: %+v", err)
+	}
+
+	if d.IsNewResource() {
+		props, err := client.GetServiceProperties(ctx, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving the Static Website for %s: %+v", id, err)
+		}
+
+		if len(flattenStaticWebsiteProperties(props)) > 0 {
+			return tf.ImportAsExistsError("azurerm_storage_account_static_website", id.ID())
+		}
+	}
+
+	props := expandStaticWebsiteProperties(expandStorageAccountServicePropertiesBlock(d, storageAccountStaticWebsiteSchema()))
+	if _, err := client.SetServiceProperties(ctx, id.Name, props); err != nil {
+		return fmt.Errorf("updating the Static Website for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceStorageAccountStaticWebsiteRead(d, meta)
+}
+
+func resourceStorageAccountStaticWebsiteRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q: %+v", id.Name, err)
+	}
+	if account == nil {
+		log.Printf("[INFO] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	client, err := storageClient.AccountsDataPlaneClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Accounts Data Plane Client: %+v", err)
+	}
+
+	props, err := client.GetServiceProperties(ctx, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(props.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving the Static Website for %s: %+v", id, err)
+	}
+
+	staticWebsite := flattenStaticWebsiteProperties(props)
+	if len(staticWebsite) == 0 {
+		log.Printf("[INFO] the Static Website for %s is disabled - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_account_id", id.ID())
+
+	return flattenStorageAccountServicePropertiesBlock(d, storageAccountStaticWebsiteSchema(), staticWebsite)
+}
+
+func resourceStorageAccountStaticWebsiteDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	account, err := storageClient.FindAccount(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q: %+v", id.Name, err)
+	}
+	if account == nil {
+		return nil
+	}
+
+	client, err := storageClient.AccountsDataPlaneClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Accounts Data Plane Client: %+v", err)
+	}
+
+	// disabling the Static Website leaves the contents of the `$web` container as-is
+	if _, err := client.SetServiceProperties(ctx, id.Name, expandStaticWebsiteProperties(nil)); err != nil {
+		return fmt.Errorf("disabling the Static Website for %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -24,6 +24,8 @@ func resourceStorageBlob() *pluginsdk.Resource {
 		Update: resourceStorageBlobUpdate,
 		Delete: resourceStorageBlobDelete,
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(sourceHashCustomizeDiff),
+
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
 			0: migration.BlobV0ToV1{},
@@ -100,10 +102,11 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			"source": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"source_uri", "source_content"},
 			},
 
+			"source_hash": sourceHashSchema(),
+
 			"source_content": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
@@ -131,7 +134,7 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"parallelism": {
-				// TODO: @tombuildsstuff - a note this only works for Page blobs
+				// the number of workers per CPU core used to upload the Pages of a Page blob, or the Blocks of a Block blob
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
 				Default:      8,
@@ -180,37 +183,17 @@ func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
-	contentMD5Raw := d.Get("content_md5").(string)
-	contentMD5 := ""
-	if contentMD5Raw != "" {
-		// Azure uses a Base64 encoded representation of the standard MD5 sum of the file
-		contentMD5, err = convertHexToBase64Encoding(d.Get("content_md5").(string))
-		if err != nil {
-			return fmt.Errorf("failed to base64 encode `content_md5` value: %s", err)
-		}
-	}
-
 	log.Printf("[DEBUG] Creating Blob %q in Container %q within Storage Account %q..", name, containerName, accountName)
-	metaDataRaw := d.Get("metadata").(map[string]interface{})
-	blobInput := BlobUpload{
-		AccountName:   accountName,
-		ContainerName: containerName,
-		BlobName:      name,
-		Client:        blobsClient,
-
-		BlobType:      d.Get("type").(string),
-		ContentType:   d.Get("content_type").(string),
-		ContentMD5:    contentMD5,
-		MetaData:      ExpandMetaData(metaDataRaw),
-		Parallelism:   d.Get("parallelism").(int),
-		Size:          d.Get("size").(int),
-		Source:        d.Get("source").(string),
-		SourceContent: d.Get("source_content").(string),
-		SourceUri:     d.Get("source_uri").(string),
+	blobInput, err := expandStorageBlobUpload(d, blobsClient, accountName, containerName, name)
+	if err != nil {
+		return err
 	}
 	if err := blobInput.Create(ctx); err != nil {
 		return fmt.Errorf("Error creating Blob %q (Container %q / Account %q): %s", name, containerName, accountName, err)
 	}
+	if err := setUploadedSourceHash(d); err != nil {
+		return err
+	}
 	log.Printf("[DEBUG] Created Blob %q in Container %q within Storage Account %q.", name, containerName, accountName)
 
 	d.SetId(id)
@@ -241,7 +224,25 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error building Blobs Client: %s", err)
 	}
 
-	if d.HasChange("access_tier") {
+	reuploaded := false
+	if !d.IsNewResource() && (d.HasChange("source") || d.HasChange("source_hash")) {
+		log.Printf("[DEBUG] Re-uploading Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
+		blobInput, err := expandStorageBlobUpload(d, blobsClient, id.AccountName, id.ContainerName, id.BlobName)
+		if err != nil {
+			return err
+		}
+		if err := blobInput.Create(ctx); err != nil {
+			return fmt.Errorf("Error re-uploading Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+		if err := setUploadedSourceHash(d); err != nil {
+			return err
+		}
+		reuploaded = true
+		log.Printf("[DEBUG] Re-uploaded Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
+	}
+
+	// uploading the Blob again resets the Access Tier to the default for the Storage Account
+	if d.HasChange("access_tier") || (reuploaded && d.Get("type").(string) == "Block" && d.Get("access_tier").(string) != "") {
 		// this is only applicable for Gen2/BlobStorage accounts
 		log.Printf("[DEBUG] Updating Access Tier for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
 		accessTier := blobs.AccessTier(d.Get("access_tier").(string))
@@ -357,9 +358,48 @@ func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("source_uri", props.CopySource)
 	}
 
+	// Blobs uploaded prior to `source_hash` being tracked use the current contents of the local file as
+	// the baseline, rather than re-uploading every existing Blob
+	if d.Get("source").(string) != "" && d.Get("source_hash").(string) == "" {
+		if err := setSourceHash(d); err != nil {
+			log.Printf("[DEBUG] Unable to compute `source_hash` for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+	}
+
 	return nil
 }
 
+func expandStorageBlobUpload(d *pluginsdk.ResourceData, client *blobs.Client, accountName, containerName, name string) (*BlobUpload, error) {
+	contentMD5Raw := d.Get("content_md5").(string)
+	contentMD5 := ""
+	if contentMD5Raw != "" {
+		// Azure uses a Base64 encoded representation of the standard MD5 sum of the file
+		var err error
+		contentMD5, err = convertHexToBase64Encoding(contentMD5Raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to base64 encode `content_md5` value: %s", err)
+		}
+	}
+
+	metaDataRaw := d.Get("metadata").(map[string]interface{})
+	return &BlobUpload{
+		AccountName:   accountName,
+		ContainerName: containerName,
+		BlobName:      name,
+		Client:        client,
+
+		BlobType:      d.Get("type").(string),
+		ContentType:   d.Get("content_type").(string),
+		ContentMD5:    contentMD5,
+		MetaData:      ExpandMetaData(metaDataRaw),
+		Parallelism:   d.Get("parallelism").(int),
+		Size:          d.Get("size").(int),
+		Source:        d.Get("source").(string),
+		SourceContent: d.Get("source_content").(string),
+		SourceUri:     d.Get("source_uri").(string),
+	}, nil
+}
+
 func resourceStorageBlobDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	storageClient := meta.(*clients.Client).Storage
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
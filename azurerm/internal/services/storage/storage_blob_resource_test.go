@@ -214,7 +214,44 @@ func TestAccStorageBlob_blockFromLocalFile(t *testing.T) {
 				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source", "type"),
+		data.ImportStep("parallelism", "size", "source", "source_hash", "type"),
+	})
+}
+
+func TestAccStorageBlob_blockFromLocalFileUpdated(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockFromLocalBlob(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("parallelism", "size", "source", "source_hash", "type"),
+		{
+			PreConfig: func() {
+				if err := repopulateTempFile(sourceBlob.Name()); err != nil {
+					t.Fatalf("Error re-populating temp file: %s", err)
+				}
+			},
+			Config: r.blockFromLocalBlob(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("parallelism", "size", "source", "source_hash", "type"),
 	})
 }
 
@@ -239,7 +276,7 @@ func TestAccStorageBlob_blockFromLocalFileWithContentMd5(t *testing.T) {
 				acceptance.TestCheckResourceAttr(data.ResourceName, "source", sourceBlob.Name()),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source", "type"),
+		data.ImportStep("parallelism", "size", "source", "source_hash", "type"),
 	})
 }
 
@@ -360,7 +397,7 @@ func TestAccStorageBlob_pageFromLocalFile(t *testing.T) {
 				data.CheckWithClient(r.blobMatchesFile(blobs.PageBlob, sourceBlob.Name())),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type", "source"),
+		data.ImportStep("parallelism", "size", "type", "source", "source_hash"),
 	})
 }
 
@@ -1060,6 +1097,15 @@ resource "azurerm_storage_container" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, accessLevel)
 }
 
+func repopulateTempFile(fileName string) error {
+	file, err := os.OpenFile(fileName, os.O_RDWR, 0600)
+	if err != nil {
+		return fmt.Errorf("Failed to open file %q: %s", fileName, err)
+	}
+
+	return populateTempFile(file)
+}
+
 func populateTempFile(input *os.File) error {
 	if err := input.Truncate(25*1024*1024 + 512); err != nil {
 		return fmt.Errorf("Failed to truncate file to 25M")
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -23,6 +24,9 @@ func resourceStorageShareFile() *pluginsdk.Resource {
 		Read:   resourceStorageShareFileRead,
 		Update: resourceStorageShareFileUpdate,
 		Delete: resourceStorageShareFileDelete,
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(sourceHashCustomizeDiff),
+
 		// TODO: replace this with an importer which validates the ID during import
 		Importer: pluginsdk.DefaultImporter(),
 
@@ -81,9 +85,10 @@ func resourceStorageShareFile() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
-				ForceNew:     true,
 			},
 
+			"source_hash": sourceHashSchema(),
+
 			"metadata": MetaDataSchema(),
 		},
 	}
@@ -140,40 +145,11 @@ func resourceStorageShareFileCreate(d *pluginsdk.ResourceData, meta interface{})
 		return tf.ImportAsExistsError("azurerm_storage_share_file", id)
 	}
 
-	input := files.CreateInput{
-		MetaData:           ExpandMetaData(d.Get("metadata").(map[string]interface{})),
-		ContentType:        utils.String(d.Get("content_type").(string)),
-		ContentEncoding:    utils.String(d.Get("content_encoding").(string)),
-		ContentDisposition: utils.String(d.Get("content_disposition").(string)),
-	}
-
-	if v, ok := d.GetOk("content_md5"); ok {
-		input.ContentMD5 = utils.String(v.(string))
-	}
-
-	var file *os.File
-	if v, ok := d.GetOk("source"); ok {
-		file, err = os.Open(v.(string))
-		if err != nil {
-			return fmt.Errorf("opening file : %s", err)
-		}
-
-		info, err := file.Stat()
-		if err != nil {
-			return fmt.Errorf("'stat'-ing File %q (File Share %q / Account %q): %+v", fileName, storageShareID.Name, storageShareID.AccountName, err)
-		}
-
-		input.ContentLength = info.Size()
-	}
-
-	if _, err := client.Create(ctx, storageShareID.AccountName, storageShareID.Name, path, fileName, input); err != nil {
-		return fmt.Errorf("creating File %q (File Share %q / Account %q): %+v", fileName, storageShareID.Name, storageShareID.AccountName, err)
+	if err := uploadStorageShareFile(ctx, d, client, storageShareID.AccountName, storageShareID.Name, path, fileName); err != nil {
+		return err
 	}
-
-	if file != nil {
-		if err := client.PutFile(ctx, storageShareID.AccountName, storageShareID.Name, path, fileName, file, 4); err != nil {
-			return fmt.Errorf("uploading File: %q (File Share %q / Account %q): %+v", fileName, storageShareID.Name, storageShareID.AccountName, err)
-		}
+	if err := setUploadedSourceHash(d); err != nil {
+		return err
 	}
 
 	resourceID := client.GetResourceID(storageShareID.AccountName, storageShareID.Name, path, fileName)
@@ -225,6 +201,17 @@ func resourceStorageShareFileUpdate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
+	if !d.IsNewResource() && (d.HasChange("source") || d.HasChange("source_hash")) {
+		log.Printf("[DEBUG] Re-uploading File %q (File Share %q / Account %q)...", id.FileName, id.ShareName, id.AccountName)
+		if err := uploadStorageShareFile(ctx, d, client, id.AccountName, id.ShareName, id.DirectoryName, id.FileName); err != nil {
+			return err
+		}
+		if err := setUploadedSourceHash(d); err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Re-uploaded File %q (File Share %q / Account %q).", id.FileName, id.ShareName, id.AccountName)
+	}
+
 	if d.HasChange("content_type") || d.HasChange("content_encoding") || d.HasChange("content_disposition") || d.HasChange("content_md5") {
 		input := files.SetPropertiesInput{
 			ContentType:        utils.String(d.Get("content_type").(string)),
@@ -302,6 +289,58 @@ func resourceStorageShareFileRead(d *pluginsdk.ResourceData, meta interface{}) e
 	d.Set("content_md5", props.ContentMD5)
 	d.Set("content_disposition", props.ContentDisposition)
 
+	// Files uploaded prior to `source_hash` being tracked use the current contents of the local file as
+	// the baseline, rather than re-uploading every existing File
+	if d.Get("source").(string) != "" && d.Get("source_hash").(string) == "" {
+		if err := setSourceHash(d); err != nil {
+			log.Printf("[DEBUG] Unable to compute `source_hash` for File %q (File Share %q / Account %q): %s", id.FileName, id.ShareName, id.AccountName, err)
+		}
+	}
+
+	return nil
+}
+
+// uploadStorageShareFile creates (or replaces) the File using the properties and `source` specified in the
+// configuration - since Azure truncates the contents of an existing File when it's created again
+func uploadStorageShareFile(ctx context.Context, d *pluginsdk.ResourceData, client *files.Client, accountName, shareName, path, fileName string) error {
+	input := files.CreateInput{
+		MetaData:           ExpandMetaData(d.Get("metadata").(map[string]interface{})),
+		ContentType:        utils.String(d.Get("content_type").(string)),
+		ContentEncoding:    utils.String(d.Get("content_encoding").(string)),
+		ContentDisposition: utils.String(d.Get("content_disposition").(string)),
+	}
+
+	if v, ok := d.GetOk("content_md5"); ok {
+		input.ContentMD5 = utils.String(v.(string))
+	}
+
+	var file *os.File
+	if v, ok := d.GetOk("source"); ok {
+		var err error
+		file, err = os.Open(v.(string))
+		if err != nil {
+			return fmt.Errorf("opening file : %s", err)
+		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil {
+			return fmt.Errorf("'stat'-ing File %q (File Share %q / Account %q): %+v", fileName, shareName, accountName, err)
+		}
+
+		input.ContentLength = info.Size()
+	}
+
+	if _, err := client.Create(ctx, accountName, shareName, path, fileName, input); err != nil {
+		return fmt.Errorf("creating File %q (File Share %q / Account %q): %+v", fileName, shareName, accountName, err)
+	}
+
+	if file != nil {
+		if err := client.PutFile(ctx, accountName, shareName, path, fileName, file, 4); err != nil {
+			return fmt.Errorf("uploading File: %q (File Share %q / Account %q): %+v", fileName, shareName, accountName, err)
+		}
+	}
+
 	return nil
 }
 
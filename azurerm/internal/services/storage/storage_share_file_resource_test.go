@@ -110,7 +110,42 @@ func TestAccAzureRMStorageShareFile_withFile(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("source"),
+		data.ImportStep("source", "source_hash"),
+	})
+}
+
+func TestAccAzureRMStorageShareFile_withFileUpdated(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_file", "test")
+	r := StorageShareFileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withFile(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source", "source_hash"),
+		{
+			PreConfig: func() {
+				if err := repopulateTempFile(sourceBlob.Name()); err != nil {
+					t.Fatalf("Error re-populating temp file: %s", err)
+				}
+			},
+			Config: r.withFile(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source", "source_hash"),
 	})
 }
 
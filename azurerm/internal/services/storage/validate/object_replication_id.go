@@ -1,6 +1,6 @@
 package validate
 
-// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+// NOTE: this is manually maintained since the Object Replication ID is a combination of two Resource IDs
 
 import (
 	"fmt"
@@ -0,0 +1,56 @@
+package tags
+
+import (
+	"strings"
+)
+
+// MergeDefaults returns the Tags which should be assigned to a Resource, being the Default Tags configured
+// in the Provider block combined with the Tags specified on the Resource - where the Tags specified on the
+// Resource take precedence over the Default Tags (Tag Names are compared case-insensitively, as in Azure)
+func MergeDefaults(defaultTags map[string]interface{}, tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(defaultTags)+len(tagsMap))
+
+	for k, v := range defaultTags {
+		if _, exists := lookup(tagsMap, k); exists {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	for k, v := range tagsMap {
+		output[k] = v
+	}
+
+	return output
+}
+
+// RemoveDefaults returns the Tags assigned to a Resource without the Default Tags configured in the Provider
+// block, such that these aren't included in the State - meaning only the Tags specified on the Resource are
+// compared with the configuration. Any Tags within `keep` (for example those specified on the Resource) are
+// retained, as are any Tags whose value differs from the Default Tag, so that this change is shown in the diff.
+func RemoveDefaults(tagsMap map[string]interface{}, defaultTags map[string]interface{}, keep map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap))
+
+	for k, v := range tagsMap {
+		if _, exists := lookup(keep, k); !exists {
+			if defaultValue, isDefault := lookup(defaultTags, k); isDefault && defaultValue == v {
+				continue
+			}
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+func lookup(tagsMap map[string]interface{}, name string) (interface{}, bool) {
+	for k, v := range tagsMap {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
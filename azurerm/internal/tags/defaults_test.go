@@ -0,0 +1,110 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeDefaults(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Defaults map[string]interface{}
+		Tags     map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:     "No Defaults",
+			Defaults: map[string]interface{}{},
+			Tags:     map[string]interface{}{"env": "prod"},
+			Expected: map[string]interface{}{"env": "prod"},
+		},
+		{
+			Name:     "No Tags",
+			Defaults: map[string]interface{}{"owner": "team"},
+			Tags:     map[string]interface{}{},
+			Expected: map[string]interface{}{"owner": "team"},
+		},
+		{
+			Name:     "Combined",
+			Defaults: map[string]interface{}{"owner": "team"},
+			Tags:     map[string]interface{}{"env": "prod"},
+			Expected: map[string]interface{}{"owner": "team", "env": "prod"},
+		},
+		{
+			Name:     "Resource Tag Wins",
+			Defaults: map[string]interface{}{"owner": "team", "env": "dev"},
+			Tags:     map[string]interface{}{"env": "prod"},
+			Expected: map[string]interface{}{"owner": "team", "env": "prod"},
+		},
+		{
+			Name:     "Resource Tag Wins Case Insensitively",
+			Defaults: map[string]interface{}{"Owner": "team"},
+			Tags:     map[string]interface{}{"owner": "someone-else"},
+			Expected: map[string]interface{}{"owner": "someone-else"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := MergeDefaults(v.Defaults, v.Tags)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestRemoveDefaults(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Tags     map[string]interface{}
+		Defaults map[string]interface{}
+		Keep     map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:     "No Defaults",
+			Tags:     map[string]interface{}{"env": "prod"},
+			Defaults: map[string]interface{}{},
+			Keep:     map[string]interface{}{},
+			Expected: map[string]interface{}{"env": "prod"},
+		},
+		{
+			Name:     "Default Removed",
+			Tags:     map[string]interface{}{"env": "prod", "owner": "team"},
+			Defaults: map[string]interface{}{"owner": "team"},
+			Keep:     map[string]interface{}{"env": "prod"},
+			Expected: map[string]interface{}{"env": "prod"},
+		},
+		{
+			Name:     "Default Removed Case Insensitively",
+			Tags:     map[string]interface{}{"OWNER": "team"},
+			Defaults: map[string]interface{}{"owner": "team"},
+			Keep:     map[string]interface{}{},
+			Expected: map[string]interface{}{},
+		},
+		{
+			Name:     "Default Also Specified On Resource",
+			Tags:     map[string]interface{}{"owner": "team"},
+			Defaults: map[string]interface{}{"owner": "team"},
+			Keep:     map[string]interface{}{"owner": "team"},
+			Expected: map[string]interface{}{"owner": "team"},
+		},
+		{
+			Name:     "Default Changed Outside Of Terraform",
+			Tags:     map[string]interface{}{"owner": "someone-else"},
+			Defaults: map[string]interface{}{"owner": "team"},
+			Keep:     map[string]interface{}{},
+			Expected: map[string]interface{}{"owner": "someone-else"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := RemoveDefaults(v.Tags, v.Defaults, v.Keep)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
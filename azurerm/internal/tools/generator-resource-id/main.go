@@ -3,8 +3,8 @@ package main
 import (
 	"flag"
 	"fmt"
+	"go/format"
 	"os"
-	"os/exec"
 	"path"
 	"path/filepath"
 	"sort"
@@ -23,6 +23,9 @@ func main() {
 	name := flag.String("name", "", "The name of this Resource Type")
 	id := flag.String("id", "", "An example of this Resource ID")
 	rewrite := flag.Bool("rewrite", false, "Should this Resource ID be parsed insensitively, to workaround an API bug?")
+	segmentAliases := flag.String("segment-aliases", "", "A comma-separated list of alternate Segment Keys which should also be parsed, in the format `{segmentKey}={alias}`")
+	manifest := flag.String("manifest", "", "The name of the file within the service package defining each Resource ID (e.g. `resourceids.go`), to generate all of these at once")
+	check := flag.Bool("check", false, "When used with `-manifest`, check the generated code matches the code on disk rather than writing it")
 	showHelp := flag.Bool("help", false, "Display this message")
 
 	flag.Parse()
@@ -32,17 +35,20 @@ func main() {
 		return
 	}
 
-	if err := run(*servicePackagePath, *name, *id, *rewrite); err != nil {
-		panic(err)
+	if *manifest != "" {
+		if err := runManifest(*servicePackagePath, *manifest, *check); err != nil {
+			fmt.Fprintf(os.Stderr, "%+v\n", err)
+			os.Exit(1)
+		}
+		return
 	}
-}
 
-func run(servicePackagePath, name, id string, shouldRewrite bool) error {
-	servicePackage, err := parseServicePackageName(servicePackagePath)
-	if err != nil {
-		return fmt.Errorf("determining Service Package Name for %q: %+v", servicePackagePath, err)
+	if err := run(*servicePackagePath, *name, *id, *rewrite, *segmentAliases); err != nil {
+		panic(err)
 	}
+}
 
+func run(servicePackagePath, name, id string, shouldRewrite bool, segmentAliases string) error {
 	parsersPath := path.Join(servicePackagePath, "/parse")
 	if err := os.Mkdir(parsersPath, 0755); err != nil && !os.IsExist(err) {
 		return fmt.Errorf("creating parse directory at %q: %+v", parsersPath, err)
@@ -53,6 +59,36 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 		return fmt.Errorf("creating validate directory at %q: %+v", validatorPath, err)
 	}
 
+	files, err := generate(servicePackagePath, name, id, shouldRewrite, segmentAliases)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := os.WriteFile(file.Path, []byte(file.Contents), 0644); err != nil {
+			return fmt.Errorf("writing %q: %+v", file.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// generatedFile is a file containing generated code, which is written to (or compared with) Path
+type generatedFile struct {
+	Path     string
+	Contents string
+}
+
+// generate returns the Parser, Validator and associated Unit Tests for this Resource ID
+func generate(servicePackagePath, name, id string, shouldRewrite bool, segmentAliases string) ([]generatedFile, error) {
+	servicePackage, err := parseServicePackageName(servicePackagePath)
+	if err != nil {
+		return nil, fmt.Errorf("determining Service Package Name for %q: %+v", servicePackagePath, err)
+	}
+
+	parsersPath := path.Join(servicePackagePath, "/parse")
+	validatorPath := path.Join(servicePackagePath, "/validate")
+
 	fileName := convertToSnakeCase(name)
 	validatorFileName := fmt.Sprintf("%s_id", fileName)
 	if strings.HasSuffix(fileName, "_test") {
@@ -61,7 +97,15 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 	}
 	resourceId, err := NewResourceID(name, *servicePackage, id)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	aliases, err := parseSegmentAliases(segmentAliases)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Segment Aliases for %q: %+v", name, err)
+	}
+	if err := resourceId.setSegmentAliases(aliases); err != nil {
+		return nil, fmt.Errorf("setting Segment Aliases for %q: %+v", name, err)
 	}
 
 	generator := ResourceIdGenerator{
@@ -69,27 +113,52 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 		ShouldRewrite: shouldRewrite,
 	}
 
-	parserFilePath := fmt.Sprintf("%s/%s.go", parsersPath, fileName)
-	if err := goFmtAndWriteToFile(parserFilePath, generator.Code()); err != nil {
-		return fmt.Errorf("generating Parser at %q: %+v", parserFilePath, err)
+	files := []generatedFile{
+		{
+			Path:     fmt.Sprintf("%s/%s.go", parsersPath, fileName),
+			Contents: generator.Code(),
+		},
+		{
+			Path:     fmt.Sprintf("%s/%s_test.go", parsersPath, fileName),
+			Contents: generator.TestCode(),
+		},
+		{
+			Path:     fmt.Sprintf("%s/%s.go", validatorPath, validatorFileName),
+			Contents: generator.ValidatorCode(),
+		},
+		{
+			Path:     fmt.Sprintf("%s/%s_test.go", validatorPath, validatorFileName),
+			Contents: generator.ValidatorTestCode(),
+		},
 	}
-
-	parserTestsFilePath := fmt.Sprintf("%s/%s_test.go", parsersPath, fileName)
-	if err := goFmtAndWriteToFile(parserTestsFilePath, generator.TestCode()); err != nil {
-		return fmt.Errorf("generating Parser Tests at %q: %+v", parserTestsFilePath, err)
+	for i, file := range files {
+		formatted, err := GolangCodeFormatter{}.Format(file.Contents)
+		if err != nil {
+			return nil, fmt.Errorf("formatting %q: %+v", file.Path, err)
+		}
+		files[i].Contents = *formatted
 	}
 
-	validatorFilePath := fmt.Sprintf("%s/%s.go", validatorPath, validatorFileName)
-	if err := goFmtAndWriteToFile(validatorFilePath, generator.ValidatorCode()); err != nil {
-		return fmt.Errorf("generating Validator at %q: %+v", validatorFilePath, err)
+	return files, nil
+}
+
+// parseSegmentAliases parses the comma-separated list of Segment Aliases in the format `{segmentKey}={alias}`
+func parseSegmentAliases(input string) (map[string][]string, error) {
+	output := make(map[string][]string)
+	if input == "" {
+		return output, nil
 	}
 
-	validatorTestsFilePath := fmt.Sprintf("%s/%s_test.go", validatorPath, validatorFileName)
-	if err := goFmtAndWriteToFile(validatorTestsFilePath, generator.ValidatorTestCode()); err != nil {
-		return fmt.Errorf("generating Validator Tests at %q: %+v", validatorTestsFilePath, err)
+	for _, v := range strings.Split(input, ",") {
+		split := strings.Split(v, "=")
+		if len(split) != 2 || split[0] == "" || split[1] == "" {
+			return nil, fmt.Errorf("expected an alias in the format `{segmentKey}={alias}` but got %q", v)
+		}
+
+		output[split[0]] = append(output[split[0]], split[1])
 	}
 
-	return nil
+	return output, nil
 }
 
 func parseServicePackageName(relativePath string) (*string, error) {
@@ -171,6 +240,10 @@ type ResourceIdSegment struct {
 
 	// SegmentValue is the value for this segment used in the Resource ID
 	SegmentValue string
+
+	// Aliases are alternate Segment Keys which are also parsed for this segment, for example
+	// where an API returns `serverFarms` rather than `serverfarms`
+	Aliases []string
 }
 
 type ResourceId struct {
@@ -315,6 +388,31 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 	}, nil
 }
 
+// setSegmentAliases assigns the alternate Segment Keys (keyed by the Segment Key) to each Segment
+func (id *ResourceId) setSegmentAliases(aliases map[string][]string) error {
+	for key, values := range aliases {
+		found := false
+		for i, segment := range id.Segments {
+			if segment.SegmentKey == key {
+				id.Segments[i].Aliases = values
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("the segment %q was not found in the Resource ID %q", key, id.IDRaw)
+		}
+	}
+
+	return nil
+}
+
+// withAliasedSegment returns the Resource ID, replacing the Segment Key for this segment with the alias
+func (id ResourceId) withAliasedSegment(segment ResourceIdSegment, alias string) string {
+	return strings.Replace(id.IDRaw, fmt.Sprintf("/%s/%s", segment.SegmentKey, segment.SegmentValue), fmt.Sprintf("/%s/%s", alias, segment.SegmentValue), 1)
+}
+
 type ResourceIdGenerator struct {
 	ResourceId
 
@@ -454,6 +552,29 @@ func (id ResourceIdGenerator) codeForParser() string {
 			continue
 		}
 
+		if len(segment.Aliases) > 0 {
+			aliases := make([]string, 0)
+			for _, alias := range segment.Aliases {
+				aliases = append(aliases, fmt.Sprintf("%q", alias))
+			}
+
+			fmtString := `
+	// the '%[2]s' segment can also be returned as %[3]s
+	%[2]sKey := "%[2]s"
+	for _, alias := range []string{%[3]s} {
+		if _, ok := id.Path[alias]; ok {
+			%[2]sKey = alias
+			break
+		}
+	}
+	if resourceId.%[1]s, err = id.PopSegment(%[2]sKey); err != nil {
+		return nil, err
+	}
+`
+			parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey, strings.Join(aliases, ", ")))
+			continue
+		}
+
 		fmtString := "\tif resourceId.%[1]s, err = id.PopSegment(\"%[2]s\"); err != nil {\n\t\treturn nil, err\n\t}"
 		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey))
 	}
@@ -515,7 +636,7 @@ func (id ResourceIdGenerator) codeForParserInsensitive() string {
   // find the correct casing for the '%[2]s' segment
   %[2]sKey := "%[2]s"
   for key := range id.Path {
-  	if strings.EqualFold(key, %[2]sKey) {
+  	if %[3]s {
   		%[2]sKey = key
   		break
   	}
@@ -524,7 +645,11 @@ func (id ResourceIdGenerator) codeForParserInsensitive() string {
     return nil, err
   }
 `
-		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey))
+		conditions := []string{fmt.Sprintf("strings.EqualFold(key, %sKey)", segment.SegmentKey)}
+		for _, alias := range segment.Aliases {
+			conditions = append(conditions, fmt.Sprintf("strings.EqualFold(key, %q)", alias))
+		}
+		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey, strings.Join(conditions, " || ")))
 	}
 	parserStatementsStr := strings.Join(parserStatements, "\n")
 	return fmt.Sprintf(`
@@ -662,6 +787,21 @@ func (id ResourceIdGenerator) testCodeForParser() string {
 		},
 `, id.IDRaw, typeName, strings.Join(expectAssignments, "\n")))
 
+	// add a successful test case for each of the aliased segments
+	for _, segment := range id.Segments {
+		for _, alias := range segment.Aliases {
+			testCases = append(testCases, fmt.Sprintf(`
+		{
+			// aliased segment %[4]s
+			Input: "%[1]s",
+			Expected: &%[2]s{
+%[3]s
+			},
+		},
+`, id.withAliasedSegment(segment, alias), typeName, strings.Join(expectAssignments, "\n"), alias))
+		}
+	}
+
 	// add an intentionally failing upper-cased test case
 	testCases = append(testCases, fmt.Sprintf(`
 		{
@@ -827,6 +967,17 @@ func (id ResourceIdGenerator) testCodeForParserInsensitive() string {
 		return string(out)
 	}))
 
+	for _, segment := range id.Segments {
+		for _, alias := range segment.Aliases {
+			testCases = append(testCases, testCaseWithTransformation(fmt.Sprintf("upper-cased aliased segment %s", alias), func(in string) string {
+				if in == segment.SegmentKey {
+					return strings.ToUpper(alias)
+				}
+				return in
+			}))
+		}
+	}
+
 	testCasesStr := strings.Join(testCases, "\n")
 	assignmentCheckStr := strings.Join(assignmentChecks, "\n")
 
@@ -1023,66 +1174,232 @@ func Test%[2]sID(t *testing.T) {
 `, id.TestPackageSuffix, id.TypeName, testCasesStr, id.ServicePackageName)
 }
 
-func goFmtAndWriteToFile(filePath, fileContents string) error {
-	fmt, err := GolangCodeFormatter{}.Format(fileContents)
+// manifestEntry is a Resource ID defined within a Manifest, for example:
+//
+//	//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Server -id=/subscriptions/.../servers/server1
+type manifestEntry struct {
+	Path           string
+	Name           string
+	ID             string
+	Rewrite        bool
+	SegmentAliases string
+}
+
+// parseManifest parses each of the Resource ID's defined using a `go:generate` directive within the Manifest
+func parseManifest(manifestPath string) ([]manifestEntry, error) {
+	contents, err := os.ReadFile(manifestPath)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("reading %q: %+v", manifestPath, err)
 	}
 
-	if err := os.WriteFile(filePath, []byte(*fmt), 0644); err != nil {
-		return err
+	entries := make([]manifestEntry, 0)
+	for i, line := range strings.Split(string(contents), "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "//go:generate ") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		argumentsIndex := -1
+		for j, field := range fields {
+			if strings.HasSuffix(field, "generator-resource-id/main.go") {
+				argumentsIndex = j + 1
+				break
+			}
+		}
+		if argumentsIndex == -1 {
+			continue
+		}
+
+		entry := manifestEntry{}
+		flags := flag.NewFlagSet(manifestPath, flag.ContinueOnError)
+		flags.StringVar(&entry.Path, "path", "./", "")
+		flags.StringVar(&entry.Name, "name", "", "")
+		flags.StringVar(&entry.ID, "id", "", "")
+		flags.BoolVar(&entry.Rewrite, "rewrite", false, "")
+		flags.StringVar(&entry.SegmentAliases, "segment-aliases", "", "")
+		if err := flags.Parse(fields[argumentsIndex:]); err != nil {
+			return nil, fmt.Errorf("parsing line %d of %q: %+v", i+1, manifestPath, err)
+		}
+
+		if entry.Name == "" || entry.ID == "" {
+			return nil, fmt.Errorf("line %d of %q must specify both `-name` and `-id`", i+1, manifestPath)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// runManifest generates each of the Resource ID's defined in the Manifest within each Service Package
+// matching `servicePackagePath` (which can be a glob, e.g. `./azurerm/internal/services/*`) - or when
+// `check` is set, returns an error if the generated code differs from the code on disk
+func runManifest(servicePackagePath, manifest string, check bool) error {
+	servicePackagePaths, err := filepath.Glob(servicePackagePath)
+	if err != nil {
+		return fmt.Errorf("finding Service Packages matching %q: %+v", servicePackagePath, err)
+	}
+
+	drift := make([]string, 0)
+	for _, servicePath := range servicePackagePaths {
+		manifestPath := filepath.Join(servicePath, manifest)
+		if _, err := os.Stat(manifestPath); os.IsNotExist(err) {
+			continue
+		}
+
+		entries, err := parseManifest(manifestPath)
+		if err != nil {
+			return err
+		}
+
+		files := make([]generatedFile, 0)
+		for _, entry := range entries {
+			generated, err := generate(filepath.Join(servicePath, entry.Path), entry.Name, entry.ID, entry.Rewrite, entry.SegmentAliases)
+			if err != nil {
+				return fmt.Errorf("generating %q from %q: %+v", entry.Name, manifestPath, err)
+			}
+			files = append(files, generated...)
+		}
+
+		if check {
+			differences, err := compareGeneratedFiles(servicePath, files)
+			if err != nil {
+				return err
+			}
+			drift = append(drift, differences...)
+			continue
+		}
+
+		if err := writeGeneratedFiles(servicePath, files); err != nil {
+			return err
+		}
+	}
+
+	if len(drift) > 0 {
+		return fmt.Errorf("the generated Resource ID's differ from the code on disk - run `make generate` to update these:\n\n%s", strings.Join(drift, "\n"))
 	}
 
 	return nil
 }
 
-type GolangCodeFormatter struct{}
+// compareGeneratedFiles returns a description of each file which differs from the generated code, including
+// any previously generated files which are no longer defined in the Manifest
+func compareGeneratedFiles(servicePath string, files []generatedFile) ([]string, error) {
+	differences := make([]string, 0)
+	expected := make(map[string]struct{}, len(files))
 
-func (f GolangCodeFormatter) Format(input string) (*string, error) {
-	tmpfile, err := os.CreateTemp("", "temp-*.go")
+	for _, file := range files {
+		expected[filepath.Clean(file.Path)] = struct{}{}
+
+		existing, err := os.ReadFile(file.Path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				differences = append(differences, fmt.Sprintf("* %s: missing", file.Path))
+				continue
+			}
+
+			return nil, fmt.Errorf("reading %q: %+v", file.Path, err)
+		}
+
+		if string(existing) != file.Contents {
+			differences = append(differences, fmt.Sprintf("* %s: differs", file.Path))
+		}
+	}
+
+	stale, err := findStaleGeneratedFiles(servicePath, expected)
 	if err != nil {
-		return nil, fmt.Errorf("creating temp file: %+v", err)
+		return nil, err
+	}
+	for _, filePath := range stale {
+		differences = append(differences, fmt.Sprintf("* %s: no longer defined in the Manifest", filePath))
 	}
 
-	defer os.Remove(tmpfile.Name()) // clean up
+	return differences, nil
+}
 
-	filePath := tmpfile.Name()
+// writeGeneratedFiles writes each of the generated files, removing any previously generated files
+// which are no longer defined in the Manifest
+func writeGeneratedFiles(servicePath string, files []generatedFile) error {
+	expected := make(map[string]struct{}, len(files))
 
-	if _, err := tmpfile.WriteString(input); err != nil {
-		return nil, fmt.Errorf("writing contents to %q: %+v", filePath, err)
-	}
+	for _, file := range files {
+		expected[filepath.Clean(file.Path)] = struct{}{}
 
-	f.runGoFmt(filePath)
-	f.runGoImports(filePath)
+		if err := os.MkdirAll(filepath.Dir(file.Path), 0755); err != nil {
+			return fmt.Errorf("creating directory for %q: %+v", file.Path, err)
+		}
 
-	contents, err := f.readFileContents(filePath)
+		if err := os.WriteFile(file.Path, []byte(file.Contents), 0644); err != nil {
+			return fmt.Errorf("writing %q: %+v", file.Path, err)
+		}
+	}
+
+	stale, err := findStaleGeneratedFiles(servicePath, expected)
 	if err != nil {
-		return nil, fmt.Errorf("reading contents from %q: %+v", filePath, err)
+		return err
+	}
+	for _, filePath := range stale {
+		if err := os.Remove(filePath); err != nil {
+			return fmt.Errorf("removing %q: %+v", filePath, err)
+		}
 	}
 
-	return contents, nil
+	return nil
 }
 
-func (f GolangCodeFormatter) runGoFmt(filePath string) {
-	cmd := exec.Command("gofmt", "-w", filePath)
-	// intentionally not using these errors since the exit codes are kinda uninteresting
-	_ = cmd.Start()
-	_ = cmd.Wait()
+// findStaleGeneratedFiles returns the Resource ID files within the `parse` and `validate` directories which
+// were generated by this tool but aren't in `expected`
+func findStaleGeneratedFiles(servicePath string, expected map[string]struct{}) ([]string, error) {
+	stale := make([]string, 0)
+
+	for _, directory := range []string{"parse", "validate"} {
+		matches, err := filepath.Glob(filepath.Join(servicePath, directory, "*.go"))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, filePath := range matches {
+			if _, ok := expected[filepath.Clean(filePath)]; ok {
+				continue
+			}
+
+			contents, err := os.ReadFile(filePath)
+			if err != nil {
+				return nil, fmt.Errorf("reading %q: %+v", filePath, err)
+			}
+
+			if isGeneratedResourceId(string(contents)) {
+				stale = append(stale, filePath)
+			}
+		}
+	}
+
+	return stale, nil
 }
 
-func (f GolangCodeFormatter) runGoImports(filePath string) {
-	cmd := exec.Command("goimports", "-w", filePath)
-	// intentionally not using these errors since the exit codes are kinda uninteresting
-	_ = cmd.Start()
-	_ = cmd.Wait()
+// isGeneratedResourceId returns whether the file contents were generated by this tool
+func isGeneratedResourceId(contents string) bool {
+	if !strings.Contains(contents, "// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten") {
+		return false
+	}
+
+	// other generators use the same header, so also check for the Resource ID Parser/Validator (and Tests)
+	return strings.Contains(contents, "azure.ParseAzureResourceID(input)") ||
+		strings.Contains(contents, "resourceid.Formatter") ||
+		(strings.Contains(contents, "package validate") && strings.Contains(contents, "/parse\"")) ||
+		(strings.Contains(contents, "package validate") && strings.Contains(contents, `_, errors := `))
 }
 
-func (f GolangCodeFormatter) readFileContents(filePath string) (*string, error) {
-	data, err := os.ReadFile(filePath)
+type GolangCodeFormatter struct{}
+
+// Format formats the generated code in the same way as `gofmt`, sorting the imports
+func (f GolangCodeFormatter) Format(input string) (*string, error) {
+	formatted, err := format.Source([]byte(input))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("formatting code: %+v", err)
 	}
 
-	contents := string(data)
+	contents := string(formatted)
 	return &contents, nil
 }
@@ -1,6 +1,10 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -54,3 +58,117 @@ func Test(t *testing.T) {
 		}
 	}
 }
+
+func TestParseSegmentAliases(t *testing.T) {
+	cases := []struct {
+		in    string
+		out   map[string][]string
+		error bool
+	}{
+		{
+			in:  "",
+			out: map[string][]string{},
+		},
+		{
+			in: "serverfarms=serverFarms",
+			out: map[string][]string{
+				"serverfarms": {"serverFarms"},
+			},
+		},
+		{
+			in: "sites=Sites,slots=deploymentSlots,slots=Slots",
+			out: map[string][]string{
+				"sites": {"Sites"},
+				"slots": {"deploymentSlots", "Slots"},
+			},
+		},
+		{
+			in:    "serverfarms",
+			error: true,
+		},
+		{
+			in:    "serverfarms=",
+			error: true,
+		},
+	}
+
+	for idx, c := range cases {
+		out, err := parseSegmentAliases(c.in)
+		if err != nil {
+			if c.error {
+				continue
+			}
+
+			t.Fatalf("%d. expected no error but got: %+v", idx, err)
+		}
+		if c.error {
+			t.Fatalf("%d. expected an error but didn't get one", idx)
+		}
+
+		if !reflect.DeepEqual(c.out, out) {
+			t.Fatalf("%d. %+v (expect) != %+v (actual)", idx, c.out, out)
+		}
+	}
+}
+
+func TestParseManifest(t *testing.T) {
+	manifest := `package example
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Server -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1
+//go:generate go run ../../tools/generator-resource-id/main.go -rewrite=true -path=./ -name=Database -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/databases/db1 -segment-aliases=databases=Databases
+//go:generate go run ../../tools/some-other-generator/main.go -name=Ignored
+`
+	manifestPath := filepath.Join(t.TempDir(), "resourceids.go")
+	if err := os.WriteFile(manifestPath, []byte(manifest), 0644); err != nil {
+		t.Fatalf("writing manifest: %+v", err)
+	}
+
+	entries, err := parseManifest(manifestPath)
+	if err != nil {
+		t.Fatalf("parsing manifest: %+v", err)
+	}
+
+	expected := []manifestEntry{
+		{
+			Path: "./",
+			Name: "Server",
+			ID:   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1",
+		},
+		{
+			Path:           "./",
+			Name:           "Database",
+			ID:             "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/databases/db1",
+			Rewrite:        true,
+			SegmentAliases: "databases=Databases",
+		},
+	}
+	if !reflect.DeepEqual(expected, entries) {
+		t.Fatalf("%+v (expect) != %+v (actual)", expected, entries)
+	}
+}
+
+func TestSegmentAliasesAreParsed(t *testing.T) {
+	resourceId, err := NewResourceID("Database", "example", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/databases/db1")
+	if err != nil {
+		t.Fatalf("building Resource ID: %+v", err)
+	}
+
+	if err := resourceId.setSegmentAliases(map[string][]string{"unknown": {"Unknown"}}); err == nil {
+		t.Fatalf("expected an error for an alias of an unknown segment but didn't get one")
+	}
+
+	if err := resourceId.setSegmentAliases(map[string][]string{"databases": {"Databases"}}); err != nil {
+		t.Fatalf("setting Segment Aliases: %+v", err)
+	}
+
+	generator := ResourceIdGenerator{
+		ResourceId:    *resourceId,
+		ShouldRewrite: true,
+	}
+	if code := generator.Code(); !strings.Contains(code, `for _, alias := range []string{"Databases"}`) {
+		t.Fatalf("expected the Parser to check for the alias `Databases` but got:\n%s", code)
+	}
+	if code := generator.TestCode(); !strings.Contains(code, "/servers/server1/Databases/db1") {
+		t.Fatalf("expected the Parser Tests to include the alias `Databases` but got:\n%s", code)
+	}
+}